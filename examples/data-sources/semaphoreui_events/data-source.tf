@@ -0,0 +1,10 @@
+# The 100 most recent events
+data "semaphoreui_events" "recent" {
+  last = 100
+}
+
+# All changes made to templates by a user
+data "semaphoreui_events" "template_changes" {
+  object_type = "template"
+  user_id     = 2
+}
@@ -0,0 +1,7 @@
+data "semaphoreui_info" "server" {}
+
+# Only register a runner when the server runs tasks on remote runners
+resource "semaphoreui_runner" "runner" {
+  count = data.semaphoreui_info.server.use_remote_runner ? 1 : 0
+  name  = "Example Global Runner"
+}
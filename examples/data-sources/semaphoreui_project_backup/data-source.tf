@@ -0,0 +1,9 @@
+data "semaphoreui_project_backup" "backup" {
+  project_id = 1
+}
+
+# Archive the project backup
+resource "local_sensitive_file" "backup" {
+  filename = "${path.module}/backups/${data.semaphoreui_project_backup.backup.meta.name}.json"
+  content  = data.semaphoreui_project_backup.backup.json
+}
@@ -0,0 +1,5 @@
+# All environments of the project whose name starts with "prod"
+data "semaphoreui_project_environments" "production" {
+  project_id = 1
+  name_regex = "^prod"
+}
@@ -0,0 +1,6 @@
+# All events about a key of the project
+data "semaphoreui_project_events" "key_changes" {
+  project_id  = 1
+  object_type = "key"
+  object_id   = 3
+}
@@ -0,0 +1,5 @@
+# All GitHub integrations of the project
+data "semaphoreui_project_integrations" "github" {
+  project_id = 1
+  name_regex = "^github-"
+}
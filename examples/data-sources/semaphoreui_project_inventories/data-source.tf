@@ -0,0 +1,5 @@
+# All static inventories of the project
+data "semaphoreui_project_inventories" "static" {
+  project_id = 1
+  type       = "static"
+}
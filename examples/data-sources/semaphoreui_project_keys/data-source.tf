@@ -0,0 +1,6 @@
+# All SSH keys of the project whose name starts with "deploy-"
+data "semaphoreui_project_keys" "deploy" {
+  project_id = 1
+  name_regex = "^deploy-"
+  type       = "ssh"
+}
@@ -0,0 +1,10 @@
+# All repositories of the project
+data "semaphoreui_project_repositories" "all" {
+  project_id = 1
+}
+
+# Repositories whose name ends with "-infra"
+data "semaphoreui_project_repositories" "infra" {
+  project_id = 1
+  name_regex = "-infra$"
+}
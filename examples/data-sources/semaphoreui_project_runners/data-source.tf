@@ -0,0 +1,5 @@
+# All runners of the project whose name starts with "linux-"
+data "semaphoreui_project_runners" "linux" {
+  project_id = 1
+  name_regex = "^linux-"
+}
@@ -0,0 +1,14 @@
+# Lookup by Task ID
+data "semaphoreui_project_task" "task" {
+  project_id = 1
+  id         = 2
+}
+
+# Lookup the last successful task of a template, including its output
+data "semaphoreui_project_task" "last_build" {
+  project_id           = 1
+  template_id          = 3
+  status               = "success"
+  include_raw_output   = true
+  raw_output_max_bytes = 65536
+}
@@ -0,0 +1,17 @@
+# All Ansible task templates of the "Deploy" view
+data "semaphoreui_project_templates" "deploy" {
+  project_id = 1
+  app        = "ansible"
+  type       = "task"
+  view_id    = 2
+}
+
+# Run every one of them nightly
+resource "semaphoreui_project_schedule" "nightly" {
+  for_each = { for template in data.semaphoreui_project_templates.deploy.templates : template.name => template }
+
+  project_id  = 1
+  template_id = each.value.id
+  name        = "Nightly ${each.key}"
+  cron_format = "0 2 * * *"
+}
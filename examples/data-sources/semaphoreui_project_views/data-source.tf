@@ -0,0 +1,4 @@
+# All views of the project
+data "semaphoreui_project_views" "all" {
+  project_id = 1
+}
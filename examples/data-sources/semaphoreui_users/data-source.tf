@@ -0,0 +1,15 @@
+# All users of the SemaphoreUI instance
+data "semaphoreui_users" "all" {}
+
+# Give every "ops-" user access to the project
+data "semaphoreui_users" "ops" {
+  name_regex = "^ops-"
+}
+
+resource "semaphoreui_project_user" "ops" {
+  for_each = { for user in data.semaphoreui_users.ops.users : user.username => user }
+
+  project_id = 1
+  user_id    = each.value.id
+  role       = "task_runner"
+}
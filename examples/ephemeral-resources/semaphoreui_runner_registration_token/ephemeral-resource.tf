@@ -0,0 +1,29 @@
+resource "semaphoreui_runner" "runner" {
+  name = "Example Runner"
+
+  # Generating a registration token leaves the runner unregistered and
+  # inactive on the server until it registers with the new token.
+  active = false
+}
+
+# Generate a one-time registration token for the (unregistered) runner. The
+# token is not stored in the plan or state.
+ephemeral "semaphoreui_runner_registration_token" "token" {
+  runner_id = semaphoreui_runner.runner.id
+}
+
+# Pass the token to the runner VM, e.g. through a write-only cloud-init
+# user data attribute of the compute provider.
+resource "example_instance" "runner" {
+  user_data_wo = templatefile("${path.module}/cloud-init.yaml.tftpl", {
+    registration_token = ephemeral.semaphoreui_runner_registration_token.token.registration_token
+  })
+  user_data_wo_version = 1
+}
+
+# For a project runner, also set project_id:
+#
+# ephemeral "semaphoreui_runner_registration_token" "token" {
+#   project_id = semaphoreui_project.project.id
+#   runner_id  = semaphoreui_project_runner.runner.id
+# }
@@ -0,0 +1,14 @@
+# Create an API token that only lives for the duration of the Terraform run,
+# and expire it once Terraform no longer needs it.
+ephemeral "semaphoreui_user_api_token" "run" {
+  name = "Terraform"
+}
+
+# Use the token to configure another provider, without storing it in the plan
+# or state.
+provider "restapi" {
+  uri = "https://semaphore.example.com/api"
+  headers = {
+    Authorization = "Bearer ${ephemeral.semaphoreui_user_api_token.run.token}"
+  }
+}
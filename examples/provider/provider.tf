@@ -12,3 +12,29 @@ provider "semaphoreui" {
   api_base_url = "http://localhost:3000/api" # Default: "http://localhost:3000/api"
   api_token    = "your token"
 }
+
+# Alternatively, log in with a username and password, e.g. to set up a fresh
+# SemaphoreUI instance. With mint_api_token, the provider uses an API token
+# that is expired at the end of the run instead of the session.
+provider "semaphoreui" {
+  alias          = "bootstrap"
+  api_base_url   = "http://localhost:3000/api"
+  username       = "admin"
+  password       = var.semaphore_admin_password
+  mint_api_token = true
+}
+
+# Connect through an authenticating reverse proxy that requires a client
+# certificate, with full TLS verification against a private CA.
+provider "semaphoreui" {
+  alias           = "proxied"
+  api_base_url    = "https://semaphore.internal.example.com/api"
+  api_token       = var.semaphore_api_token
+  ca_cert_file    = "/etc/ssl/certs/internal-ca.pem"
+  client_cert_pem = file("client.crt")
+  client_key_pem  = file("client.key")
+  request_timeout = "1m"
+  headers = {
+    "X-Proxy-Token" = var.proxy_token
+  }
+}
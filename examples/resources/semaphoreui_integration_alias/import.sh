@@ -5,6 +5,10 @@ terraform import semaphoreui_integration_alias.example project/1/integration/2/a
 # Project-scoped alias (no integration):
 # Import ID is "project/{project_id}/alias/{alias_id}".
 terraform import semaphoreui_integration_alias.example project/1/alias/3
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_integration_alias.example "project/Infra/integration/GitHub/alias/3"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
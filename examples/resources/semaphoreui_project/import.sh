@@ -1,6 +1,10 @@
 # Import ID is specified by the string "project/{project_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
 terraform import semaphoreui_project.example project/1
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project.example "project/Infra"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
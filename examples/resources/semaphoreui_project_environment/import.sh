@@ -2,9 +2,13 @@
 # will be blank as SemaphoreUI does not return these values on the API.
 #
 # Import ID is specified by the string "project/{project_id}/environment/{environment_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {environment_id} is the ID of the environment in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {environment_id} is the ID or name of the environment in SemaphoreUI.
 terraform import semaphoreui_project_environment.example project/1/environment/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_environment.example "project/Infra/environment/Production"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
@@ -31,3 +31,23 @@ resource "semaphoreui_project_environment" "environment" {
     value = "value4"
   }]
 }
+
+# Write-only secrets fetched from a secret store like Vault. The `value_wo`
+# values are sent to SemaphoreUI on apply but never persisted to Terraform
+# state. Bump the matching `value_wo_version` to rotate a secret.
+ephemeral "vault_kv_secret_v2" "cloud" {
+  mount = "secret"
+  name  = "cloud-credentials"
+}
+
+resource "semaphoreui_project_environment" "ephemeral" {
+  project_id = semaphoreui_project.project.id
+  name       = "Ephemeral Secrets"
+
+  secrets = [{
+    name             = "AWS_SECRET_ACCESS_KEY"
+    type             = "env"
+    value_wo         = ephemeral.vault_kv_secret_v2.cloud.data["aws_secret_access_key"]
+    value_wo_version = 1
+  }]
+}
@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/integration/{integration_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {integration_id} is the ID of the integration in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {integration_id} is the ID or name of the integration in SemaphoreUI.
 terraform import semaphoreui_project_integration.example project/1/integration/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_integration.example "project/Infra/integration/GitHub"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
@@ -0,0 +1,64 @@
+resource "semaphoreui_project" "project" {
+  name = "Example Project"
+}
+
+resource "semaphoreui_project_key" "none" {
+  project_id = semaphoreui_project.project.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "repository" {
+  project_id = semaphoreui_project.project.id
+  name       = "Example Repository"
+  url        = "https://github.com/semaphoreui/semaphore.git"
+  branch     = "develop"
+  ssh_key_id = semaphoreui_project_key.none.id
+}
+
+resource "semaphoreui_project_inventory" "inventory" {
+  project_id = semaphoreui_project.project.id
+  name       = "Example Inventory"
+  ssh_key_id = semaphoreui_project_key.none.id
+  static = {
+    inventory = "localhost ansible_connection=local"
+  }
+}
+
+resource "semaphoreui_project_template" "deploy" {
+  project_id    = semaphoreui_project.project.id
+  inventory_id  = semaphoreui_project_inventory.inventory.id
+  repository_id = semaphoreui_project_repository.repository.id
+  name          = "Deploy"
+  playbook      = "deploy.yml"
+}
+
+resource "semaphoreui_project_integration" "deploy" {
+  project_id  = semaphoreui_project.project.id
+  template_id = semaphoreui_project_template.deploy.id
+  name        = "github-push"
+  auth_method = "github"
+}
+
+# Pass the pushed commit SHA to the playbook as an extra variable.
+resource "semaphoreui_project_integration_extract_value" "commit_sha" {
+  project_id     = semaphoreui_project.project.id
+  integration_id = semaphoreui_project_integration.deploy.id
+  name           = "Commit SHA"
+  value_source   = "body"
+  body_data_type = "json"
+  key            = "head_commit.id"
+  variable       = "commit_sha"
+  variable_type  = "task"
+}
+
+# Expose the GitHub delivery ID as an environment variable.
+resource "semaphoreui_project_integration_extract_value" "delivery_id" {
+  project_id     = semaphoreui_project.project.id
+  integration_id = semaphoreui_project_integration.deploy.id
+  name           = "Delivery ID"
+  value_source   = "header"
+  key            = "X-GitHub-Delivery"
+  variable       = "GITHUB_DELIVERY_ID"
+  variable_type  = "environment"
+}
@@ -0,0 +1,16 @@
+# Import ID is specified by the string "project/{project_id}/integration/{integration_id}/matcher/{matcher_id}".
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {integration_id} is the ID or name of the integration in SemaphoreUI.
+# - {matcher_id} is the ID or name of the matcher in SemaphoreUI.
+terraform import semaphoreui_project_integration_matcher.example project/1/integration/2/matcher/3
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_integration_matcher.example "project/Infra/integration/GitHub/matcher/Push"
+```
+Or using `import {}` block in the configuration file:
+```hcl
+import {
+  to = semaphoreui_project_integration_matcher.example
+  id = "project/1/integration/2/matcher/3"
+}
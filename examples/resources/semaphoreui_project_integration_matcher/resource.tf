@@ -0,0 +1,63 @@
+resource "semaphoreui_project" "project" {
+  name = "Example Project"
+}
+
+resource "semaphoreui_project_key" "none" {
+  project_id = semaphoreui_project.project.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "repository" {
+  project_id = semaphoreui_project.project.id
+  name       = "Example Repository"
+  url        = "https://github.com/semaphoreui/semaphore.git"
+  branch     = "develop"
+  ssh_key_id = semaphoreui_project_key.none.id
+}
+
+resource "semaphoreui_project_inventory" "inventory" {
+  project_id = semaphoreui_project.project.id
+  name       = "Example Inventory"
+  ssh_key_id = semaphoreui_project_key.none.id
+  static = {
+    inventory = "localhost ansible_connection=local"
+  }
+}
+
+resource "semaphoreui_project_template" "deploy" {
+  project_id    = semaphoreui_project.project.id
+  inventory_id  = semaphoreui_project_inventory.inventory.id
+  repository_id = semaphoreui_project_repository.repository.id
+  name          = "Deploy"
+  playbook      = "deploy.yml"
+}
+
+resource "semaphoreui_project_integration" "deploy" {
+  project_id  = semaphoreui_project.project.id
+  template_id = semaphoreui_project_template.deploy.id
+  name        = "github-push"
+  auth_method = "github"
+}
+
+# Only run on pushes...
+resource "semaphoreui_project_integration_matcher" "push_event" {
+  project_id     = semaphoreui_project.project.id
+  integration_id = semaphoreui_project_integration.deploy.id
+  name           = "Push event"
+  match_type     = "header"
+  key            = "X-GitHub-Event"
+  value          = "push"
+}
+
+# ...to the main branch.
+resource "semaphoreui_project_integration_matcher" "main_branch" {
+  project_id     = semaphoreui_project.project.id
+  integration_id = semaphoreui_project_integration.deploy.id
+  name           = "Main branch"
+  match_type     = "body"
+  body_data_type = "json"
+  key            = "ref"
+  method         = "equals"
+  value          = "refs/heads/main"
+}
@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/inventory/{inventory_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {inventory_id} is the ID of the inventory in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {inventory_id} is the ID or name of the inventory in SemaphoreUI.
 terraform import semaphoreui_project_inventory.example project/1/inventory/1
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_inventory.example "project/Infra/inventory/Production"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
@@ -0,0 +1,8 @@
+# Import ID is specified by the string "project/{project_id}/invite/{invite_id}".
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {invite_id} is the ID of the invite in SemaphoreUI, or the email it was sent to.
+terraform import semaphoreui_project_invite.example project/1/invite/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_invite.example "project/Infra/invite/alice@example.com"
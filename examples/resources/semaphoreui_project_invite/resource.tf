@@ -0,0 +1,26 @@
+resource "semaphoreui_project" "project" {
+  name = "Example Project"
+}
+
+# Onboard contractors by email. They join the project once they accept the
+# invitation, without a local user and password to share.
+variable "contractors" {
+  type    = set(string)
+  default = ["alice@example.com", "bob@example.com"]
+}
+
+resource "semaphoreui_project_invite" "contractor" {
+  for_each = var.contractors
+
+  project_id = semaphoreui_project.project.id
+  email      = each.value
+  role       = "task_runner"
+}
+
+# An invitation with a custom expiry.
+resource "semaphoreui_project_invite" "auditor" {
+  project_id = semaphoreui_project.project.id
+  email      = "auditor@example.com"
+  role       = "guest"
+  expires_at = "2030-01-31T22:00:00Z"
+}
@@ -2,9 +2,13 @@
 # will be blank as SemaphoreUI does not return these values on the API.
 #
 # Import ID is specified by the string "project/{project_id}/key/{key_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {key_id} is the ID of the key in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {key_id} is the ID or name of the key in SemaphoreUI.
 terraform import semaphoreui_project_key.example project/1/key/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_key.example "project/Infra/key/Deploy key"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
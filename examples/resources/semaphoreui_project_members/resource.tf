@@ -0,0 +1,28 @@
+resource "semaphoreui_project" "project" {
+  name = "Example Project"
+}
+
+# The user of the provider credentials, who owns the projects it creates.
+data "semaphoreui_user" "admin" {
+  username = "admin"
+}
+
+resource "semaphoreui_user" "user" {
+  name     = "Example User"
+  username = "example"
+  email    = "user@example.com"
+}
+
+resource "semaphoreui_project_members" "members" {
+  project_id = semaphoreui_project.project.id
+  members = [
+    {
+      user_id = data.semaphoreui_user.admin.id
+      role    = "owner"
+    },
+    {
+      user_id = semaphoreui_user.user.id
+      role    = "task_runner"
+    },
+  ]
+}
@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/repository/{repository_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {repository_id} is the ID of the repository in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {repository_id} is the ID or name of the repository in SemaphoreUI.
 terraform import semaphoreui_project_repository.example project/1/repository/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_repository.example "project/Infra/repository/Playbooks"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
@@ -0,0 +1,13 @@
+# Create a project from a golden backup file
+resource "semaphoreui_project_restore" "staging" {
+  backup = file("${path.module}/golden-project.json")
+  name   = "Staging"
+}
+
+# Use objects created from the backup, resolved by name
+resource "semaphoreui_project_schedule" "nightly" {
+  project_id  = semaphoreui_project_restore.staging.id
+  template_id = semaphoreui_project_restore.staging.template_ids["Deploy"]
+  name        = "Nightly Deploy"
+  cron_format = "0 2 * * *"
+}
@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/runner/{runner_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {runner_id} is the ID of the runner in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {runner_id} is the ID or name of the runner in SemaphoreUI.
 terraform import semaphoreui_project_runner.example project/1/runner/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_runner.example "project/Infra/runner/Build runner"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
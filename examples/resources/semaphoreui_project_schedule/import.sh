@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/schedule/{schedule_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
 # - {schedule_id} is the ID of the schedule in SemaphoreUI.
 terraform import semaphoreui_project_schedule.example project/1/schedule/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_schedule.example "project/Infra/schedule/2"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
@@ -14,3 +14,19 @@ resource "semaphoreui_project_schedule" "schedule" {
   cron_format = "0 0 * * *"
   enabled     = true
 }
+
+# Run the template once, in check mode and from another branch.
+resource "semaphoreui_project_schedule" "maintenance" {
+  project_id  = semaphoreui_project.project.id
+  template_id = data.semaphoreui_project_template.template.id
+  name        = "Maintenance Window"
+  run_at      = "2025-01-31T22:00:00Z"
+  enabled     = true
+
+  task_params = {
+    git_branch = "maintenance"
+    ansible = {
+      dry_run = true
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# Import ID is specified by the string "project/{project_id}/task/{task_id}".
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {task_id} is the ID of the task in SemaphoreUI.
+#
+# The imported task is only kept if its message, git_branch, arguments,
+# environment and limit match the configuration, and the configuration does not
+# set ansible, terraform or triggers. Otherwise the next apply runs a new task.
+terraform import semaphoreui_project_task.example project/1/task/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_task.example "project/Infra/task/2"
+```
+Or using `import {}` block in the configuration file:
+```hcl
+import {
+  to = semaphoreui_project_task.example
+  id = "project/1/task/2"
+}
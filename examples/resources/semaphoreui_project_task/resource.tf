@@ -0,0 +1,68 @@
+resource "semaphoreui_project" "project" {
+  name = "Project"
+}
+
+resource "semaphoreui_project_key" "none" {
+  project_id = semaphoreui_project.project.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "repo" {
+  project_id = semaphoreui_project.project.id
+  name       = "Repo"
+  url        = "git@github.com:example/test.git"
+  branch     = "main"
+  ssh_key_id = semaphoreui_project_key.none.id
+}
+
+resource "semaphoreui_project_inventory" "inventory" {
+  project_id = semaphoreui_project.project.id
+  name       = "Inventory"
+  ssh_key_id = semaphoreui_project_key.none.id
+  file = {
+    path          = "path/to/inventory"
+    repository_id = semaphoreui_project_repository.repo.id
+  }
+}
+
+resource "semaphoreui_project_environment" "environment" {
+  project_id = semaphoreui_project.project.id
+  name       = "Environment"
+  secrets = [{
+    name  = "SECRET_ONE"
+    type  = "var"
+    value = "VALUE_ONE"
+  }]
+}
+
+resource "semaphoreui_project_template" "bootstrap" {
+  project_id                  = semaphoreui_project.project.id
+  environment_id              = semaphoreui_project_environment.environment.id
+  inventory_id                = semaphoreui_project_inventory.inventory.id
+  repository_id               = semaphoreui_project_repository.repo.id
+  name                        = "Bootstrap Host"
+  playbook                    = "bootstrap.yml"
+  allow_override_args_in_task = true
+}
+
+# Runs the bootstrap playbook and waits for it to finish. A new task is run
+# whenever the host address changes.
+resource "semaphoreui_project_task" "bootstrap" {
+  project_id  = semaphoreui_project.project.id
+  template_id = semaphoreui_project_template.bootstrap.id
+  message     = "Bootstrap web-1"
+  limit       = "web-1"
+  environment = jsonencode({
+    HOST = "10.0.0.10"
+  })
+  ansible = {
+    tags = ["bootstrap"]
+    diff = true
+  }
+  timeout = "1h"
+
+  triggers = {
+    host = "10.0.0.10"
+  }
+}
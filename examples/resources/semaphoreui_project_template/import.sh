@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/template/{template_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {template_id} is the ID of the template in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {template_id} is the ID or name of the template in SemaphoreUI.
 terraform import semaphoreui_project_template.example project/1/template/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_template.example "project/Infra/template/Deploy web"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
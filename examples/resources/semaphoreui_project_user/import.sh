@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/user/{user_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {user_id} is the ID of the user in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {user_id} is the ID or username of the user in SemaphoreUI.
 terraform import semaphoreui_project_user.example project/1/user/3
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_user.example "project/Infra/user/jdoe"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
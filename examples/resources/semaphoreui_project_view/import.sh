@@ -1,7 +1,11 @@
 # Import ID is specified by the string "project/{project_id}/view/{view_id}".
-# - {project_id} is the ID of the project in SemaphoreUI.
-# - {view_id} is the ID of the view in SemaphoreUI.
+# - {project_id} is the ID or name of the project in SemaphoreUI.
+# - {view_id} is the ID or title of the view in SemaphoreUI.
 terraform import semaphoreui_project_view.example project/1/view/2
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_project_view.example "project/Infra/view/Deployments"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
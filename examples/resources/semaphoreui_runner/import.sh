@@ -1,6 +1,10 @@
 # Import ID is specified by the string "runner/{runner_id}".
-# - {runner_id} is the ID of the global runner in SemaphoreUI.
+# - {runner_id} is the ID or name of the global runner in SemaphoreUI.
 terraform import semaphoreui_runner.example runner/1
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_runner.example "runner/Build runner"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
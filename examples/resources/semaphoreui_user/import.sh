@@ -1,6 +1,10 @@
 # Import ID is specified by the string "user/{user_id}".
-# - {user_id} is the ID of the user in SemaphoreUI.
+# - {user_id} is the ID or username of the user in SemaphoreUI.
 terraform import semaphoreui_user.example user/1
+
+# Names can be used instead of IDs, except for numeric names. Slashes in names
+# are escaped as "\/", or "\\/" in HCL strings. Ambiguous names are an error.
+terraform import semaphoreui_user.example "user/jdoe"
 ```
 Or using `import {}` block in the configuration file:
 ```hcl
@@ -8,3 +8,19 @@ resource "semaphoreui_user" "example" {
   alert    = false
   external = false
 }
+
+# Write-only password fetched from a secret store like Vault. It is sent to
+# SemaphoreUI on apply but never persisted to Terraform state. Bump
+# `password_wo_version` to rotate the password.
+ephemeral "vault_kv_secret_v2" "operator" {
+  mount = "secret"
+  name  = "semaphore-operator"
+}
+
+resource "semaphoreui_user" "operator" {
+  username            = "operator"
+  name                = "Operator"
+  email               = "operator@example.com"
+  password_wo         = ephemeral.vault_kv_secret_v2.operator.data["password"]
+  password_wo_version = 1
+}
@@ -0,0 +1,9 @@
+resource "semaphoreui_user_api_token" "ci" {
+  name = "CI"
+}
+
+# Pass the token to a CI system, e.g. as a secret variable.
+output "ci_api_token" {
+  value     = semaphoreui_user_api_token.ci.token
+  sensitive = true
+}
@@ -0,0 +1,154 @@
+package fakesemaphore
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// backupFields are the fields of the objects of a backup that are copied as
+// they are, by collection.
+var backupFields = map[string][]string{
+	"keys":         {"name", "type"},
+	"repositories": {"name", "git_url", "git_branch"},
+	"inventory":    {"name", "inventory", "type"},
+	"environment":  {"name", "env", "json", "password"},
+	"views":        {"title", "position"},
+	"templates": {
+		"name", "playbook", "arguments", "description", "type", "app", "start_version", "autorun",
+		"allow_override_args_in_task", "suppress_success_alerts", "survey_vars",
+	},
+}
+
+// backupRefs are the fields of the objects of a backup that refer to other
+// objects by name, with the fields of the IDs they stand for and the
+// collections of the objects, by collection.
+var backupRefs = map[string][][3]string{
+	"repositories": {{"ssh_key", "ssh_key_id", "keys"}},
+	"inventory":    {{"ssh_key", "ssh_key_id", "keys"}, {"become_key", "become_key_id", "keys"}},
+	"templates": {
+		{"inventory", "inventory_id", "inventory"},
+		{"repository", "repository_id", "repositories"},
+		{"view", "view_id", "views"},
+		{"build_template", "build_template_id", "templates"},
+	},
+}
+
+// backupSections are the sections of a backup, with their collections, in
+// the order they are restored.
+var backupSections = [][2]string{
+	{"keys", "keys"},
+	{"repositories", "repositories"},
+	{"inventories", "inventory"},
+	{"environments", "environment"},
+	{"views", "views"},
+	{"templates", "templates"},
+}
+
+// backupName returns the name of an object in a backup.
+func backupName(collection string, o object) string {
+	if collection == "views" {
+		return fmt.Sprint(o["title"])
+	}
+	return fmt.Sprint(o["name"])
+}
+
+// backupProject exports a project like SemaphoreUI does: objects refer to
+// each other by name instead of ID, and secrets are left out.
+func (s *Server) backupProject(req *request) (int, any) {
+	project := s.objects["/projects"][req.params["project_id"]]
+	backup := object{"meta": copyFields(project, projectFields)}
+
+	names := make(map[string]map[int64]string)
+	for _, section := range backupSections {
+		names[section[1]] = make(map[int64]string)
+		for _, o := range s.objects[expand("/project/{project_id}/"+section[1], req.params)] {
+			names[section[1]][id(o)] = backupName(section[1], o)
+		}
+	}
+
+	for _, section := range backupSections {
+		items := []object{}
+		for _, o := range s.sorted(expand("/project/{project_id}/"+section[1], req.params)) {
+			item := copyFields(o, backupFields[section[1]])
+			for _, ref := range backupRefs[section[1]] {
+				if name, ok := names[ref[2]][toInt64(o[ref[1]])]; ok {
+					item[ref[0]] = name
+				}
+			}
+			if environmentIDs, ok := o["environment_ids"].([]int64); ok && len(environmentIDs) > 0 {
+				item["environment"] = names["environment"][environmentIDs[0]]
+			}
+			items = append(items, item)
+		}
+		backup[section[0]] = items
+	}
+	return http.StatusOK, backup
+}
+
+// restoreProject creates a project from a backup.
+func (s *Server) restoreProject(req *request) (int, any) {
+	var backup map[string]any
+	if err := decode(req, &backup); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	meta, _ := backup["meta"].(object)
+	project, err := s.newProject(req, meta)
+	if err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+
+	ids := make(map[string]map[string]int64)
+	for _, section := range backupSections {
+		collection := fmt.Sprintf("/project/%d/%s", id(project), section[1])
+		ids[section[1]] = make(map[string]int64)
+		items, _ := backup[section[0]].([]any)
+		var restored [][2]object
+		for _, item := range items {
+			item, _ := item.(object)
+			o := copyFields(item, backupFields[section[1]])
+			o["project_id"] = id(project)
+			switch section[1] {
+			case "keys":
+				if o["type"] == nil {
+					o["type"] = "none"
+				}
+			case "environment":
+				o["secrets"] = []object{}
+			case "templates":
+				o["environment_id"] = 0
+				o["environment_ids"] = []int64{}
+				if environmentID, ok := ids["environment"][fmt.Sprint(item["environment"])]; ok {
+					o["environment_ids"] = []int64{environmentID}
+				}
+				if o["survey_vars"] == nil {
+					o["survey_vars"] = []any{}
+				}
+			}
+			o = s.insert(collection, o)
+			ids[section[1]][backupName(section[1], o)] = id(o)
+			restored = append(restored, [2]object{item, o})
+		}
+
+		// References are resolved once all objects of the section exist, as
+		// templates refer to other templates.
+		for _, pair := range restored {
+			for _, ref := range backupRefs[section[1]] {
+				if refID, ok := ids[ref[2]][fmt.Sprint(pair[0][ref[0]])]; ok {
+					pair[1][ref[1]] = refID
+				}
+			}
+		}
+	}
+	return http.StatusOK, project
+}
+
+// copyFields returns a copy of the fields of an object that are set.
+func copyFields(o object, fields []string) object {
+	result := make(object)
+	for _, field := range fields {
+		if value, ok := o[field]; ok && value != nil {
+			result[field] = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package fakesemaphore
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestServer_backupRestore(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	project := "/project/" + itoa(projectID)
+	keyID := testCreate(t, s, project+"/keys", map[string]any{"name": "None", "type": "none"})
+	repositoryID := testCreate(t, s, project+"/repositories", map[string]any{"name": "Playbooks", "git_url": "https://example.com/playbooks.git", "ssh_key_id": keyID})
+	inventoryID := testCreate(t, s, project+"/inventory", map[string]any{"name": "Hosts", "type": "static", "inventory": "localhost", "ssh_key_id": keyID})
+	environmentID := testCreate(t, s, project+"/environment", map[string]any{"name": "Production"})
+	testCreate(t, s, project+"/templates", map[string]any{
+		"name":           "Deploy",
+		"playbook":       "deploy.yml",
+		"repository_id":  repositoryID,
+		"inventory_id":   inventoryID,
+		"environment_id": environmentID,
+	})
+
+	status, backup := testRequest(t, s, http.MethodGet, project+"/backup", nil)
+	testExpectStatus(t, "GET backup", status, http.StatusOK, backup)
+	templates := backup.(map[string]any)["templates"].([]any)
+	if len(templates) != 1 {
+		t.Fatalf("expected 1 template in the backup, got %v", templates)
+	}
+	if template := templates[0].(map[string]any); template["repository"] != "Playbooks" || template["environment"] != "Production" {
+		t.Errorf("expected the template to refer to objects by name, got %v", template)
+	}
+
+	backup.(map[string]any)["meta"].(map[string]any)["name"] = "Restored"
+	restoredID := testCreate(t, s, "/projects/restore", backup)
+	restored := "/project/" + itoa(restoredID)
+
+	_, body := testRequest(t, s, http.MethodGet, restored+"/repositories", nil)
+	repositories := body.([]any)
+	if len(repositories) != 1 {
+		t.Fatalf("expected 1 restored repository, got %v", repositories)
+	}
+	_, body = testRequest(t, s, http.MethodGet, restored+"/templates", nil)
+	template := body.([]any)[0].(map[string]any)
+	if template["repository_id"] != repositories[0].(map[string]any)["id"] {
+		t.Errorf("expected the restored template to refer to the restored repository, got %v", template)
+	}
+	if template["project_id"] != float64(restoredID) {
+		t.Errorf("expected the template to belong to the restored project, got %v", template)
+	}
+}
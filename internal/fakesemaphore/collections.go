@@ -0,0 +1,208 @@
+package fakesemaphore
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// kind describes a type of object that is stored in collections, e.g. the
+// keys of each project, and served by the generic handlers below.
+type kind struct {
+	// name is the object type of events, e.g. "template".
+	name string
+
+	// collection is the path of the collections, e.g.
+	// /project/{project_id}/keys, and param the path parameter of the IDs
+	// of the objects, e.g. key_id.
+	collection string
+	param      string
+
+	// title is the field that names the objects.
+	title string
+
+	// hidden are the fields that are stored but never returned, such as
+	// secrets.
+	hidden []string
+
+	// refs maps the fields that hold IDs of other objects of the project to
+	// the collections of those objects, e.g. ssh_key_id to keys.
+	refs map[string]string
+
+	// prepare validates and completes an object before it is stored.
+	// previous is the stored object on updates, and nil on creation.
+	prepare func(s *Server, req *request, o, previous object) error
+
+	// deleted cleans up after an object was deleted, e.g. references to it.
+	deleted func(s *Server, req *request, o object)
+}
+
+// item returns the path pattern of the objects of the kind.
+func (k *kind) item() string {
+	return k.collection + "/{" + k.param + "}"
+}
+
+// expand replaces the parameters of a path pattern with the values of the
+// request.
+func expand(pattern string, params map[string]int64) string {
+	return routeParamRegex.ReplaceAllStringFunc(pattern, func(param string) string {
+		return strconv.FormatInt(params[strings.Trim(param, "{}")], 10)
+	})
+}
+
+// visible returns a copy of an object without its hidden fields.
+func (k *kind) visible(o object) object {
+	result := make(object, len(o))
+	for key, value := range o {
+		if !slices.Contains(k.hidden, key) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// lookup returns the object of the request, or nil.
+func (s *Server) lookup(k *kind, req *request) object {
+	return s.objects[expand(k.collection, req.params)][req.params[k.param]]
+}
+
+// sorted returns the objects of a collection ordered by ID.
+func (s *Server) sorted(collection string) []object {
+	result := make([]object, 0, len(s.objects[collection]))
+	for _, o := range s.objects[collection] {
+		result = append(result, o)
+	}
+	slices.SortFunc(result, func(a, b object) int {
+		return int(id(a) - id(b))
+	})
+	return result
+}
+
+// projectObject returns an object of a collection of the project of the
+// request, e.g. the key with an ID, or nil.
+func (s *Server) projectObject(req *request, collection string, objectID int64) object {
+	return s.objects[fmt.Sprintf("/project/%d/%s", req.params["project_id"], collection)][objectID]
+}
+
+// checkRefs checks that the objects an object refers to exist in the
+// project, as SemaphoreUI rejects references to missing objects.
+func (s *Server) checkRefs(k *kind, req *request, o object) error {
+	for field, collection := range k.refs {
+		ref := toInt64(o[field])
+		if ref != 0 && s.projectObject(req, collection, ref) == nil {
+			return fmt.Errorf("invalid %s: object %d does not exist in project %d", field, ref, req.params["project_id"])
+		}
+	}
+	return nil
+}
+
+// store validates an object of a request, completes it with the IDs of the
+// request path, and stores it in place of previous.
+func (s *Server) store(k *kind, req *request, o, previous object) (int, any) {
+	for param, field := range map[string]string{"project_id": "project_id", "integration_id": "integration_id"} {
+		if value, ok := req.params[param]; ok {
+			o[field] = value
+		}
+	}
+	if err := s.checkRefs(k, req, o); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	if k.prepare != nil {
+		if err := k.prepare(s, req, o, previous); err != nil {
+			return http.StatusBadRequest, errorBody("%s", err)
+		}
+	}
+
+	if previous == nil {
+		o = s.insert(expand(k.collection, req.params), o)
+		s.addEvent(req, k.name, id(o), req.params["project_id"], fmt.Sprintf("%s %v created", k.name, o[k.title]))
+		return http.StatusCreated, k.visible(o)
+	}
+	o["id"] = id(previous)
+	s.objects[expand(k.collection, req.params)][id(o)] = o
+	s.addEvent(req, k.name, id(o), req.params["project_id"], fmt.Sprintf("%s %v updated", k.name, o[k.title]))
+	return http.StatusNoContent, nil
+}
+
+// list returns the handler that lists the objects of a collection.
+func (s *Server) list(k *kind) handler {
+	return func(req *request) (int, any) {
+		result := []object{}
+		for _, o := range s.sorted(expand(k.collection, req.params)) {
+			result = append(result, k.visible(o))
+		}
+		return http.StatusOK, result
+	}
+}
+
+// get returns the handler that reads an object.
+func (s *Server) get(k *kind) handler {
+	return func(req *request) (int, any) {
+		o := s.lookup(k, req)
+		if o == nil {
+			return http.StatusNotFound, errorBody("%s not found", k.name)
+		}
+		return http.StatusOK, k.visible(o)
+	}
+}
+
+// create returns the handler that creates an object and responds with the
+// status code of the API operation.
+func (s *Server) create(k *kind, status int) handler {
+	return func(req *request) (int, any) {
+		var o object
+		if err := decode(req, &o); err != nil {
+			return http.StatusBadRequest, errorBody("%s", err)
+		}
+		delete(o, "id")
+		code, body := s.store(k, req, o, nil)
+		if code == http.StatusCreated {
+			code = status
+		}
+		return code, body
+	}
+}
+
+// update returns the handler that replaces an object.
+func (s *Server) update(k *kind) handler {
+	return func(req *request) (int, any) {
+		previous := s.lookup(k, req)
+		if previous == nil {
+			return http.StatusNotFound, errorBody("%s not found", k.name)
+		}
+		var o object
+		if err := decode(req, &o); err != nil {
+			return http.StatusBadRequest, errorBody("%s", err)
+		}
+		return s.store(k, req, o, previous)
+	}
+}
+
+// remove returns the handler that deletes an object and the objects below
+// it.
+func (s *Server) remove(k *kind) handler {
+	return func(req *request) (int, any) {
+		o := s.lookup(k, req)
+		if o == nil {
+			return http.StatusNotFound, errorBody("%s not found", k.name)
+		}
+		s.deleteTree(expand(k.collection, req.params), expand(k.item(), req.params), id(o))
+		if k.deleted != nil {
+			k.deleted(s, req, o)
+		}
+		s.addEvent(req, k.name, id(o), req.params["project_id"], fmt.Sprintf("%s %v deleted", k.name, o[k.title]))
+		return http.StatusNoContent, nil
+	}
+}
+
+// handleCollection registers the handlers to list, create, read, update and
+// delete the objects of a kind. created is the status code of a creation.
+func (s *Server) handleCollection(k *kind, created int) {
+	s.handle(http.MethodGet, k.collection, s.list(k))
+	s.handle(http.MethodPost, k.collection, s.create(k, created))
+	s.handle(http.MethodGet, k.item(), s.get(k))
+	s.handle(http.MethodPut, k.item(), s.update(k))
+	s.handle(http.MethodDelete, k.item(), s.remove(k))
+}
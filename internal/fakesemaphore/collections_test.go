@@ -0,0 +1,82 @@
+package fakesemaphore
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestServer_collection(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	views := "/project/" + itoa(projectID) + "/views"
+
+	viewID := testCreate(t, s, views, map[string]any{"title": "Deploy", "position": 1})
+	status, body := testRequest(t, s, http.MethodGet, views+"/"+itoa(viewID), nil)
+	testExpectStatus(t, "GET view", status, http.StatusOK, body)
+	view := body.(map[string]any)
+	if view["title"] != "Deploy" || view["project_id"] != float64(projectID) {
+		t.Errorf("expected the view to be stored with its project, got %v", view)
+	}
+
+	status, body = testRequest(t, s, http.MethodPut, views+"/"+itoa(viewID), map[string]any{"title": "Release", "position": 2})
+	testExpectStatus(t, "PUT view", status, http.StatusNoContent, body)
+	_, body = testRequest(t, s, http.MethodGet, views, nil)
+	if list := body.([]any); len(list) != 1 || list[0].(map[string]any)["title"] != "Release" {
+		t.Errorf("expected the updated view to be listed, got %v", list)
+	}
+
+	status, body = testRequest(t, s, http.MethodPost, views, map[string]any{"position": 3})
+	testExpectStatus(t, "POST view without title", status, http.StatusBadRequest, body)
+
+	status, body = testRequest(t, s, http.MethodDelete, views+"/"+itoa(viewID), nil)
+	testExpectStatus(t, "DELETE view", status, http.StatusNoContent, body)
+	if status, _ := testRequest(t, s, http.MethodGet, views+"/"+itoa(viewID), nil); status != http.StatusNotFound {
+		t.Errorf("expected 404 for the deleted view, got %d", status)
+	}
+	if status, _ := testRequest(t, s, http.MethodDelete, views+"/"+itoa(viewID), nil); status != http.StatusNotFound {
+		t.Errorf("expected 404 when deleting the deleted view again, got %d", status)
+	}
+}
+
+func TestServer_collectionSecrets(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	keys := "/project/" + itoa(projectID) + "/keys"
+	testCreate(t, s, keys, map[string]any{
+		"name":           "Deploy",
+		"type":           "login_password",
+		"login_password": map[string]any{"login": "deploy", "password": "secret"},
+	})
+
+	_, body := testRequest(t, s, http.MethodGet, keys, nil)
+	key := body.([]any)[0].(map[string]any)
+	if _, ok := key["login_password"]; ok {
+		t.Errorf("expected the secret of the key not to be returned, got %v", key)
+	}
+	if key["type"] != "login_password" {
+		t.Errorf("expected the type of the key to be returned, got %v", key)
+	}
+}
+
+func TestServer_collectionReferences(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	otherProjectID := testCreate(t, s, "/projects", map[string]any{"name": "Other"})
+	keyID := testCreate(t, s, "/project/"+itoa(otherProjectID)+"/keys", map[string]any{"name": "None", "type": "none"})
+
+	status, body := testRequest(t, s, http.MethodPost, "/project/"+itoa(projectID)+"/repositories", map[string]any{
+		"name":       "Playbooks",
+		"git_url":    "https://example.com/playbooks.git",
+		"ssh_key_id": keyID,
+	})
+	testExpectStatus(t, "POST repository with a key of another project", status, http.StatusBadRequest, body)
+	if message := body.(map[string]any)["error"]; message == nil {
+		t.Errorf("expected an error message, got %v", body)
+	}
+}
@@ -0,0 +1,890 @@
+package fakesemaphore
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// projectRoles are the roles of project members.
+var projectRoles = []string{"owner", "manager", "task_runner", "guest"}
+
+// playbookApps are the template apps that run a playbook, and require one.
+var playbookApps = []string{"", "ansible"}
+
+var (
+	userKind = &kind{
+		name:       "user",
+		collection: "/users",
+		param:      "user_id",
+		title:      "username",
+		prepare:    prepareUser,
+		deleted:    deletedUser,
+	}
+	keyKind = &kind{
+		name:       "key",
+		collection: "/project/{project_id}/keys",
+		param:      "key_id",
+		title:      "name",
+		hidden:     []string{"login_password", "ssh", "override_secret"},
+		prepare:    prepareKey,
+	}
+	repositoryKind = &kind{
+		name:       "repository",
+		collection: "/project/{project_id}/repositories",
+		param:      "repository_id",
+		title:      "name",
+		refs:       map[string]string{"ssh_key_id": "keys"},
+		prepare:    requireFields("name", "git_url"),
+	}
+	inventoryKind = &kind{
+		name:       "inventory",
+		collection: "/project/{project_id}/inventory",
+		param:      "inventory_id",
+		title:      "name",
+		refs:       map[string]string{"ssh_key_id": "keys", "become_key_id": "keys", "repository_id": "repositories"},
+		prepare:    requireFields("name"),
+	}
+	environmentKind = &kind{
+		name:       "environment",
+		collection: "/project/{project_id}/environment",
+		param:      "environment_id",
+		title:      "name",
+		prepare:    prepareEnvironment,
+	}
+	viewKind = &kind{
+		name:       "view",
+		collection: "/project/{project_id}/views",
+		param:      "view_id",
+		title:      "title",
+		prepare:    requireFields("title"),
+		deleted:    deletedView,
+	}
+	templateKind = &kind{
+		name:       "template",
+		collection: "/project/{project_id}/templates",
+		param:      "template_id",
+		title:      "name",
+		refs:       map[string]string{"inventory_id": "inventory", "repository_id": "repositories", "view_id": "views", "build_template_id": "templates"},
+		prepare:    prepareTemplate,
+		deleted:    deletedTemplate,
+	}
+	scheduleKind = &kind{
+		name:       "schedule",
+		collection: "/project/{project_id}/schedules",
+		param:      "schedule_id",
+		title:      "name",
+		refs:       map[string]string{"template_id": "templates"},
+		prepare:    prepareSchedule,
+	}
+	integrationKind = &kind{
+		name:       "integration",
+		collection: "/project/{project_id}/integrations",
+		param:      "integration_id",
+		title:      "name",
+		refs:       map[string]string{"template_id": "templates", "auth_secret_id": "keys"},
+		prepare:    requireFields("name"),
+	}
+	extractValueKind = &kind{
+		name:       "integration value",
+		collection: "/project/{project_id}/integrations/{integration_id}/values",
+		param:      "extractvalue_id",
+		title:      "name",
+		prepare:    requireFields("name"),
+	}
+	matcherKind = &kind{
+		name:       "integration matcher",
+		collection: "/project/{project_id}/integrations/{integration_id}/matchers",
+		param:      "matcher_id",
+		title:      "name",
+		prepare:    requireFields("name"),
+	}
+	projectAliasKind = &kind{
+		name:       "integration alias",
+		collection: "/project/{project_id}/integrations/aliases",
+		param:      "alias_id",
+		title:      "url",
+	}
+	integrationAliasKind = &kind{
+		name:       "integration alias",
+		collection: "/project/{project_id}/integrations/{integration_id}/aliases",
+		param:      "alias_id",
+		title:      "url",
+	}
+	runnerKind = &kind{
+		name:       "runner",
+		collection: "/runners",
+		param:      "runner_id",
+		title:      "name",
+		prepare:    prepareRunner,
+	}
+	projectRunnerKind = &kind{
+		name:       "runner",
+		collection: "/project/{project_id}/runners",
+		param:      "runner_id",
+		title:      "name",
+		prepare:    prepareRunner,
+	}
+	taskKind = &kind{
+		name:       "task",
+		collection: "/project/{project_id}/tasks",
+		param:      "task_id",
+		title:      "message",
+		hidden:     []string{"output"},
+	}
+	inviteKind = &kind{
+		name:       "invite",
+		collection: "/project/{project_id}/invites",
+		param:      "invite_id",
+		title:      "email",
+		prepare:    prepareInvite,
+	}
+)
+
+func (s *Server) registerRoutes() {
+	s.handlePublic(http.MethodGet, "/ping", func(*request) (int, any) { return http.StatusOK, "pong" })
+	s.handlePublic(http.MethodPost, "/auth/login", s.login)
+	s.handle(http.MethodPost, "/auth/logout", s.logout)
+	s.handle(http.MethodGet, "/info", s.info)
+	s.handle(http.MethodGet, "/events", s.listEvents(false))
+	s.handle(http.MethodGet, "/events/last", s.listEvents(true))
+
+	s.handle(http.MethodGet, "/user", s.currentUser)
+	s.handle(http.MethodGet, "/user/tokens", s.listTokens)
+	s.handle(http.MethodPost, "/user/tokens", s.createToken)
+	s.handle(http.MethodDelete, "/user/tokens/{api_token_id}", s.expireToken)
+
+	s.handle(http.MethodGet, "/users", s.list(userKind))
+	s.handle(http.MethodPost, "/users", s.createUser)
+	s.handle(http.MethodGet, "/users/{user_id}", s.get(userKind))
+	s.handle(http.MethodPut, "/users/{user_id}", s.update(userKind))
+	s.handle(http.MethodDelete, "/users/{user_id}", s.remove(userKind))
+	s.handle(http.MethodPost, "/users/{user_id}/password", s.setPassword)
+
+	s.handle(http.MethodGet, "/projects", s.listProjects)
+	s.handle(http.MethodPost, "/projects", s.createProject)
+	s.handle(http.MethodPost, "/projects/restore", s.restoreProject)
+	s.handle(http.MethodGet, "/project/{project_id}", s.getProject)
+	s.handle(http.MethodPut, "/project/{project_id}", s.updateProject)
+	s.handle(http.MethodDelete, "/project/{project_id}", s.deleteProject)
+	s.handle(http.MethodGet, "/project/{project_id}/backup", s.backupProject)
+	s.handle(http.MethodGet, "/project/{project_id}/events", s.listEvents(false))
+
+	s.handle(http.MethodGet, "/project/{project_id}/users", s.listProjectUsers)
+	s.handle(http.MethodPost, "/project/{project_id}/users", s.addProjectUser)
+	s.handle(http.MethodPut, "/project/{project_id}/users/{user_id}", s.updateProjectUser)
+	s.handle(http.MethodDelete, "/project/{project_id}/users/{user_id}", s.removeProjectUser)
+	s.handle(http.MethodGet, inviteKind.collection, s.list(inviteKind))
+	s.handle(http.MethodPost, inviteKind.collection, s.createInvite)
+	s.handle(http.MethodGet, inviteKind.item(), s.get(inviteKind))
+	s.handle(http.MethodPut, inviteKind.item(), s.updateInvite)
+	s.handle(http.MethodDelete, inviteKind.item(), s.remove(inviteKind))
+
+	s.handleCollection(keyKind, http.StatusCreated)
+	s.handleCollection(repositoryKind, http.StatusCreated)
+	s.handleCollection(inventoryKind, http.StatusCreated)
+	s.handleCollection(environmentKind, http.StatusCreated)
+	s.handleCollection(viewKind, http.StatusCreated)
+	s.handleCollection(templateKind, http.StatusCreated)
+	s.handleCollection(scheduleKind, http.StatusCreated)
+	s.handle(http.MethodGet, "/project/{project_id}/templates/{template_id}/schedules", s.listTemplateSchedules)
+
+	s.handle(http.MethodGet, projectAliasKind.collection, s.list(projectAliasKind))
+	s.handle(http.MethodPost, projectAliasKind.collection, s.createAlias(projectAliasKind))
+	s.handle(http.MethodDelete, projectAliasKind.item(), s.remove(projectAliasKind))
+	s.handleCollection(integrationKind, http.StatusCreated)
+	s.handleCollection(extractValueKind, http.StatusCreated)
+	s.handleCollection(matcherKind, http.StatusOK)
+	s.handle(http.MethodGet, integrationAliasKind.collection, s.list(integrationAliasKind))
+	s.handle(http.MethodPost, integrationAliasKind.collection, s.createAlias(integrationAliasKind))
+	s.handle(http.MethodDelete, integrationAliasKind.item(), s.remove(integrationAliasKind))
+
+	for _, k := range []*kind{runnerKind, projectRunnerKind} {
+		s.handleCollection(k, http.StatusCreated)
+		s.handle(http.MethodPost, k.item()+"/active", s.setRunnerActive(k))
+		s.handle(http.MethodPost, k.item()+"/registration-token", s.createRegistrationToken(k))
+	}
+
+	s.handle(http.MethodGet, taskKind.collection, s.listTasks(false))
+	s.handle(http.MethodGet, taskKind.collection+"/last", s.listTasks(true))
+	s.handle(http.MethodPost, taskKind.collection, s.createTask)
+	s.handle(http.MethodGet, taskKind.item(), s.get(taskKind))
+	s.handle(http.MethodDelete, taskKind.item(), s.remove(taskKind))
+	s.handle(http.MethodPost, taskKind.item()+"/stop", s.stopTask)
+	s.handle(http.MethodGet, taskKind.item()+"/output", s.taskOutput)
+	s.handle(http.MethodGet, taskKind.item()+"/raw_output", s.taskRawOutput)
+}
+
+// requireFields returns a prepare function that rejects objects without the
+// fields.
+func requireFields(fields ...string) func(*Server, *request, object, object) error {
+	return func(_ *Server, _ *request, o, _ object) error {
+		for _, field := range fields {
+			if value, _ := o[field].(string); strings.TrimSpace(value) == "" {
+				return fmt.Errorf("%s can not be empty", field)
+			}
+		}
+		return nil
+	}
+}
+
+func (s *Server) login(req *request) (int, any) {
+	var body struct {
+		Auth     string `json:"auth"`
+		Password string `json:"password"`
+	}
+	if err := decode(req, &body); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	for _, user := range s.objects["/users"] {
+		if (user["username"] == body.Auth || user["email"] == body.Auth) && s.passwords[id(user)] == body.Password && body.Password != "" {
+			session := randomString()
+			s.sessions[session] = id(user)
+			cookie := &http.Cookie{Name: sessionCookie, Value: session, Path: "/", HttpOnly: true}
+			req.header.Add("Set-Cookie", cookie.String())
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusUnauthorized, errorBody("invalid login or password")
+}
+
+func (s *Server) logout(req *request) (int, any) {
+	if cookie, err := req.Cookie(sessionCookie); err == nil {
+		delete(s.sessions, cookie.Value)
+	}
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) info(*request) (int, any) {
+	return http.StatusOK, object{
+		"version":           Version,
+		"ansible":           "ansible [core 2.17.0]",
+		"git_client":        "cmd_git",
+		"schedule_timezone": "UTC",
+		"use_remote_runner": true,
+		"web_host":          s.URL,
+		"auth_methods":      object{"totp": object{"enabled": false}, "email": object{"enabled": false}},
+		"features":          object{"project_runners": true, "terraform": true, "task_summary": true},
+	}
+}
+
+// listEvents returns the handler of the event log of the project of the
+// request, or of all projects. last limits the events to the 200 newest,
+// like SemaphoreUI does.
+func (s *Server) listEvents(last bool) handler {
+	return func(req *request) (int, any) {
+		projectID, ok := req.params["project_id"]
+		result := []object{}
+		for _, event := range s.events {
+			if ok && toInt64(event["project_id"]) != projectID {
+				continue
+			}
+			result = append(result, event)
+		}
+		if last && len(result) > 200 {
+			result = result[:200]
+		}
+		return http.StatusOK, result
+	}
+}
+
+func (s *Server) currentUser(req *request) (int, any) {
+	return http.StatusOK, req.user
+}
+
+func (s *Server) listTokens(req *request) (int, any) {
+	result := []object{}
+	for _, token := range s.tokens {
+		if token.userID == id(req.user) && token.id != Token {
+			result = append(result, object{"id": token.id, "created": token.created, "expired": token.expired, "user_id": token.userID})
+		}
+	}
+	slices.SortFunc(result, func(a, b object) int {
+		return strings.Compare(a["created"].(string)+a["id"].(string), b["created"].(string)+b["id"].(string))
+	})
+	return http.StatusOK, result
+}
+
+func (s *Server) createToken(req *request) (int, any) {
+	token := &apiToken{id: randomString(), created: now(), userID: id(req.user)}
+	s.tokens[token.id] = token
+	return http.StatusCreated, object{"id": token.id, "created": token.created, "expired": false, "user_id": token.userID}
+}
+
+// expireToken expires a token of the user. SemaphoreUI keeps expired tokens
+// in the list of tokens.
+func (s *Server) expireToken(req *request) (int, any) {
+	token, ok := s.tokens[req.values["api_token_id"]]
+	if !ok || token.userID != id(req.user) || token.id == Token {
+		return http.StatusNotFound, errorBody("token not found")
+	}
+	token.expired = true
+	return http.StatusNoContent, nil
+}
+
+// prepareUser rejects users whose username or email is taken. The password
+// is not part of the user, see createUser and setPassword.
+func prepareUser(s *Server, _ *request, o, previous object) error {
+	if err := requireFields("username")(s, nil, o, previous); err != nil {
+		return err
+	}
+	for _, user := range s.objects["/users"] {
+		if previous != nil && id(user) == id(previous) {
+			continue
+		}
+		if user["username"] == o["username"] || (o["email"] != "" && o["email"] != nil && user["email"] == o["email"]) {
+			return errors.New("user with this username or email already exists")
+		}
+	}
+	delete(o, "password")
+	if previous == nil {
+		o["created"] = now()
+	} else {
+		// The update request has no external field.
+		o["created"] = previous["created"]
+		o["external"] = previous["external"]
+	}
+	return nil
+}
+
+func deletedUser(s *Server, _ *request, o object) {
+	delete(s.passwords, id(o))
+	for path, members := range s.objects {
+		if strings.HasPrefix(path, "/project/") && strings.HasSuffix(path, "/users") {
+			delete(members, id(o))
+		}
+	}
+	for key, token := range s.tokens {
+		if token.userID == id(o) {
+			delete(s.tokens, key)
+		}
+	}
+}
+
+func (s *Server) createUser(req *request) (int, any) {
+	var o object
+	if err := decode(req, &o); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	delete(o, "id")
+	password, _ := o["password"].(string)
+	status, body := s.store(userKind, req, o, nil)
+	if user, ok := body.(object); ok && status == http.StatusCreated {
+		s.passwords[id(user)] = password
+	}
+	return status, body
+}
+
+func (s *Server) setPassword(req *request) (int, any) {
+	if s.lookup(userKind, req) == nil {
+		return http.StatusNotFound, errorBody("user not found")
+	}
+	var body struct {
+		Password string `json:"password"`
+	}
+	if err := decode(req, &body); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	s.passwords[req.params["user_id"]] = body.Password
+	return http.StatusNoContent, nil
+}
+
+// projectFields are the fields of projects that requests set.
+var projectFields = []string{"name", "alert", "alert_chat", "max_parallel_tasks", "type"}
+
+func (s *Server) listProjects(req *request) (int, any) {
+	result := []object{}
+	for _, project := range s.sorted("/projects") {
+		if isAdmin(req.user) || s.objects[fmt.Sprintf("/project/%d/users", id(project))][id(req.user)] != nil {
+			result = append(result, project)
+		}
+	}
+	return http.StatusOK, result
+}
+
+// newProject creates a project of which the user of the request is the
+// owner.
+func (s *Server) newProject(req *request, body object) (object, error) {
+	project := object{"created": now()}
+	for _, field := range projectFields {
+		if value, ok := body[field]; ok {
+			project[field] = value
+		}
+	}
+	if err := requireFields("name")(s, req, project, nil); err != nil {
+		return nil, err
+	}
+	project = s.insert("/projects", project)
+	s.objects[fmt.Sprintf("/project/%d/users", id(project))] = map[int64]object{
+		id(req.user): {"id": id(req.user), "role": "owner"},
+	}
+	s.addEvent(req, "project", id(project), id(project), fmt.Sprintf("project %v created", project["name"]))
+	return project, nil
+}
+
+func (s *Server) createProject(req *request) (int, any) {
+	var body object
+	if err := decode(req, &body); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	project, err := s.newProject(req, body)
+	if err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	return http.StatusCreated, project
+}
+
+func (s *Server) getProject(req *request) (int, any) {
+	return http.StatusOK, s.objects["/projects"][req.params["project_id"]]
+}
+
+func (s *Server) updateProject(req *request) (int, any) {
+	var body object
+	if err := decode(req, &body); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	previous := s.objects["/projects"][req.params["project_id"]]
+	project := object{"id": id(previous), "created": previous["created"]}
+	for _, field := range projectFields {
+		if value, ok := body[field]; ok {
+			project[field] = value
+		}
+	}
+	if err := requireFields("name")(s, req, project, previous); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	s.objects["/projects"][id(project)] = project
+	s.addEvent(req, "project", id(project), id(project), fmt.Sprintf("project %v updated", project["name"]))
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) deleteProject(req *request) (int, any) {
+	projectID := req.params["project_id"]
+	project := s.objects["/projects"][projectID]
+	s.deleteTree("/projects", fmt.Sprintf("/project/%d", projectID), projectID)
+	s.addEvent(req, "project", projectID, 0, fmt.Sprintf("project %v deleted", project["name"]))
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) listProjectUsers(req *request) (int, any) {
+	result := []object{}
+	for _, member := range s.sorted(expand("/project/{project_id}/users", req.params)) {
+		user := s.objects["/users"][id(member)]
+		result = append(result, object{
+			"id":       id(member),
+			"role":     member["role"],
+			"username": user["username"],
+			"name":     user["name"],
+		})
+	}
+	return http.StatusOK, result
+}
+
+func (s *Server) addProjectUser(req *request) (int, any) {
+	var body struct {
+		UserID int64  `json:"user_id"`
+		Role   string `json:"role"`
+	}
+	if err := decode(req, &body); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	if s.objects["/users"][body.UserID] == nil {
+		return http.StatusBadRequest, errorBody("invalid user_id: user %d does not exist", body.UserID)
+	}
+	if !slices.Contains(projectRoles, body.Role) {
+		return http.StatusBadRequest, errorBody("invalid role %q", body.Role)
+	}
+	members := s.objects[expand("/project/{project_id}/users", req.params)]
+	if members[body.UserID] != nil {
+		return http.StatusBadRequest, errorBody("user %d is already a member of the project", body.UserID)
+	}
+	members[body.UserID] = object{"id": body.UserID, "role": body.Role}
+	s.addEvent(req, "user", body.UserID, req.params["project_id"], fmt.Sprintf("user %d added to the project", body.UserID))
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) updateProjectUser(req *request) (int, any) {
+	member := s.objects[expand("/project/{project_id}/users", req.params)][req.params["user_id"]]
+	if member == nil {
+		return http.StatusNotFound, errorBody("user is not a member of the project")
+	}
+	var body struct {
+		Role string `json:"role"`
+	}
+	if err := decode(req, &body); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	if !slices.Contains(projectRoles, body.Role) {
+		return http.StatusBadRequest, errorBody("invalid role %q", body.Role)
+	}
+	member["role"] = body.Role
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) removeProjectUser(req *request) (int, any) {
+	members := s.objects[expand("/project/{project_id}/users", req.params)]
+	if members[req.params["user_id"]] == nil {
+		return http.StatusNotFound, errorBody("user is not a member of the project")
+	}
+	delete(members, req.params["user_id"])
+	s.addEvent(req, "user", req.params["user_id"], req.params["project_id"], fmt.Sprintf("user %d removed from the project", req.params["user_id"]))
+	return http.StatusNoContent, nil
+}
+
+// inviteStatuses are the statuses that an invite can be set to. Invites
+// become accepted when the invited user accepts them.
+var inviteStatuses = []string{"pending", "declined", "expired"}
+
+// prepareInvite completes a new invite with the fields that the server
+// manages. Invites expire after 7 days by default, like in SemaphoreUI.
+func prepareInvite(s *Server, req *request, o, _ object) error {
+	if err := requireFields("email")(s, req, o, nil); err != nil {
+		return err
+	}
+	if role, _ := o["role"].(string); !slices.Contains(projectRoles, role) {
+		return fmt.Errorf("invalid role %q", o["role"])
+	}
+	if expiresAt, _ := o["expires_at"].(string); expiresAt == "" || strings.HasPrefix(expiresAt, "0001-") {
+		o["expires_at"] = time.Now().UTC().Add(7 * 24 * time.Hour).Format(time.RFC3339)
+	}
+	o["status"] = "pending"
+	o["created"] = now()
+	o["inviter_user_id"] = id(req.user)
+	return nil
+}
+
+// createInvite creates an invite, unless the email already has a pending
+// invite or belongs to a member of the project.
+func (s *Server) createInvite(req *request) (int, any) {
+	var o object
+	if err := decode(req, &o); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	delete(o, "id")
+	for _, invite := range s.objects[expand(inviteKind.collection, req.params)] {
+		if invite["email"] == o["email"] && invite["status"] == "pending" {
+			return http.StatusConflict, errorBody("invitation already exists")
+		}
+	}
+	members := s.objects[expand("/project/{project_id}/users", req.params)]
+	for _, user := range s.objects["/users"] {
+		if user["email"] == o["email"] && members[id(user)] != nil {
+			return http.StatusConflict, errorBody("user is already a member of the project")
+		}
+	}
+	return s.store(inviteKind, req, o, nil)
+}
+
+// updateInvite sets the status of an invite. Accepted invites can no longer
+// be changed.
+func (s *Server) updateInvite(req *request) (int, any) {
+	invite := s.lookup(inviteKind, req)
+	if invite == nil {
+		return http.StatusNotFound, errorBody("invite not found")
+	}
+	var body struct {
+		Status string `json:"status"`
+	}
+	if err := decode(req, &body); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	if !slices.Contains(inviteStatuses, body.Status) {
+		return http.StatusBadRequest, errorBody("invalid status %q", body.Status)
+	}
+	if invite["status"] == "accepted" {
+		return http.StatusBadRequest, errorBody("invitation was already accepted")
+	}
+	invite["status"] = body.Status
+	s.addEvent(req, inviteKind.name, id(invite), req.params["project_id"], fmt.Sprintf("invite %v updated", invite["email"]))
+	return http.StatusNoContent, nil
+}
+
+// prepareKey checks the type of a key. Unless override_secret is set, an
+// update keeps the secrets of the key, as SemaphoreUI does.
+func prepareKey(s *Server, req *request, o, previous object) error {
+	if err := requireFields("name")(s, req, o, previous); err != nil {
+		return err
+	}
+	if !slices.Contains([]any{"none", "ssh", "login_password"}, o["type"]) {
+		return fmt.Errorf("invalid key type %v", o["type"])
+	}
+	if override, _ := o["override_secret"].(bool); previous != nil && !override && o["type"] == previous["type"] {
+		o["ssh"] = previous["ssh"]
+		o["login_password"] = previous["login_password"]
+	}
+	delete(o, "override_secret")
+	return nil
+}
+
+// prepareEnvironment applies the operations on the secrets of an
+// environment. Like SemaphoreUI, the fake keeps the values of secrets to
+// itself: they are never returned.
+func prepareEnvironment(s *Server, req *request, o, previous object) error {
+	if err := requireFields("name")(s, req, o, previous); err != nil {
+		return err
+	}
+
+	var secrets []object
+	if previous != nil {
+		secrets, _ = previous["secrets"].([]object)
+	}
+	requested, _ := o["secrets"].([]any)
+	for _, item := range requested {
+		secret, _ := item.(object)
+		secretID := toInt64(secret["id"])
+		operation, _ := secret["operation"].(string)
+		if operation == "" && secretID == 0 {
+			operation = "create"
+		}
+		index := slices.IndexFunc(secrets, func(o object) bool { return id(o) == secretID })
+		switch operation {
+		case "create":
+			s.lastID++
+			secrets = append(secrets, object{"id": s.lastID, "name": secret["name"], "type": secret["type"]})
+		case "update":
+			if index < 0 {
+				return fmt.Errorf("secret %d does not exist", secretID)
+			}
+			secrets[index] = object{"id": secretID, "name": secret["name"], "type": secret["type"]}
+		case "delete":
+			if index >= 0 {
+				secrets = slices.Delete(secrets, index, index+1)
+			}
+		default:
+			return fmt.Errorf("invalid secret operation %q", operation)
+		}
+	}
+	if secrets == nil {
+		secrets = []object{}
+	}
+	o["secrets"] = secrets
+	return nil
+}
+
+func deletedView(s *Server, req *request, o object) {
+	for _, template := range s.objects[expand(templateKind.collection, req.params)] {
+		if toInt64(template["view_id"]) == id(o) {
+			delete(template, "view_id")
+		}
+	}
+}
+
+// prepareTemplate reproduces how SemaphoreUI v2.16+ stores the environments
+// of templates: the legacy environment_id is accepted, but moved to
+// environment_ids and read back as 0.
+func prepareTemplate(s *Server, req *request, o, previous object) error {
+	if err := requireFields("name")(s, req, o, previous); err != nil {
+		return err
+	}
+	if app, _ := o["app"].(string); slices.Contains(playbookApps, app) {
+		if err := requireFields("playbook")(s, req, o, previous); err != nil {
+			return errors.New("template playbook can not be empty")
+		}
+	}
+
+	var environmentIDs []int64
+	if ids, ok := o["environment_ids"].([]any); ok {
+		for _, environmentID := range ids {
+			if toInt64(environmentID) != 0 {
+				environmentIDs = append(environmentIDs, toInt64(environmentID))
+			}
+		}
+	}
+	if len(environmentIDs) == 0 && toInt64(o["environment_id"]) != 0 {
+		environmentIDs = []int64{toInt64(o["environment_id"])}
+	}
+	for _, environmentID := range environmentIDs {
+		if s.projectObject(req, "environment", environmentID) == nil {
+			return fmt.Errorf("invalid environment_id: object %d does not exist in project %d", environmentID, req.params["project_id"])
+		}
+	}
+	o["environment_id"] = 0
+	o["environment_ids"] = environmentIDs
+
+	vaults, _ := o["vaults"].([]any)
+	for _, item := range vaults {
+		vault, _ := item.(object)
+		if vaultKeyID := toInt64(vault["vault_key_id"]); vaultKeyID != 0 && s.projectObject(req, "keys", vaultKeyID) == nil {
+			return fmt.Errorf("invalid vault_key_id: object %d does not exist in project %d", vaultKeyID, req.params["project_id"])
+		}
+		if toInt64(vault["id"]) == 0 {
+			s.lastID++
+			vault["id"] = s.lastID
+		}
+	}
+	if o["survey_vars"] == nil {
+		o["survey_vars"] = []any{}
+	}
+	return nil
+}
+
+// deletedTemplate deletes the schedules of a template, like SemaphoreUI.
+func deletedTemplate(s *Server, req *request, o object) {
+	schedules := s.objects[expand(scheduleKind.collection, req.params)]
+	for scheduleID, schedule := range schedules {
+		if toInt64(schedule["template_id"]) == id(o) {
+			delete(schedules, scheduleID)
+		}
+	}
+}
+
+func prepareSchedule(_ *Server, _ *request, o, _ object) error {
+	if toInt64(o["template_id"]) == 0 {
+		return errors.New("invalid template_id: a template is required")
+	}
+	if o["type"] == "run_at" {
+		if runAt, _ := o["run_at"].(string); runAt == "" || strings.HasPrefix(runAt, "0001-") {
+			return errors.New("invalid run_at: a time is required")
+		}
+		return nil
+	}
+	cron, _ := o["cron_format"].(string)
+	if len(strings.Fields(cron)) != 5 {
+		return fmt.Errorf("invalid cron_format %q", cron)
+	}
+	return nil
+}
+
+func (s *Server) listTemplateSchedules(req *request) (int, any) {
+	if s.lookup(templateKind, req) == nil {
+		return http.StatusNotFound, errorBody("template not found")
+	}
+	result := []object{}
+	for _, schedule := range s.sorted(expand(scheduleKind.collection, req.params)) {
+		if toInt64(schedule["template_id"]) == req.params["template_id"] {
+			result = append(result, schedule)
+		}
+	}
+	return http.StatusOK, result
+}
+
+// createAlias returns the handler that creates an integration alias, whose
+// URL is generated by the server.
+func (s *Server) createAlias(k *kind) handler {
+	return func(req *request) (int, any) {
+		o := s.insert(expand(k.collection, req.params), object{"url": s.URL + "/api/integrations/" + randomString()})
+		s.addEvent(req, k.name, id(o), req.params["project_id"], fmt.Sprintf("%s %v created", k.name, o["url"]))
+		return http.StatusOK, o
+	}
+}
+
+// prepareRunner completes runners with the fields that the server manages.
+// Runners only get a token when they register, which the fake does not
+// support.
+func prepareRunner(s *Server, req *request, o, previous object) error {
+	if err := requireFields("name")(s, req, o, previous); err != nil {
+		return err
+	}
+	if o["tags"] == nil {
+		o["tags"] = []any{}
+	}
+	o["registered"] = false
+	return nil
+}
+
+func (s *Server) setRunnerActive(k *kind) handler {
+	return func(req *request) (int, any) {
+		runner := s.lookup(k, req)
+		if runner == nil {
+			return http.StatusNotFound, errorBody("runner not found")
+		}
+		var body struct {
+			Active bool `json:"active"`
+		}
+		if err := decode(req, &body); err != nil {
+			return http.StatusBadRequest, errorBody("%s", err)
+		}
+		runner["active"] = body.Active
+		return http.StatusNoContent, nil
+	}
+}
+
+func (s *Server) createRegistrationToken(k *kind) handler {
+	return func(req *request) (int, any) {
+		runner := s.lookup(k, req)
+		if runner == nil {
+			return http.StatusNotFound, errorBody("runner not found")
+		}
+		token := object{"runner_id": id(runner), "registration_token": randomString()}
+		if projectID, ok := req.params["project_id"]; ok {
+			token["project_id"] = projectID
+		}
+		return http.StatusOK, token
+	}
+}
+
+// listTasks returns the handler that lists the tasks of a project, the
+// newest first. last limits them to the 200 newest, like SemaphoreUI does.
+func (s *Server) listTasks(last bool) handler {
+	return func(req *request) (int, any) {
+		tasks := s.sorted(expand(taskKind.collection, req.params))
+		slices.Reverse(tasks)
+		if last && len(tasks) > 200 {
+			tasks = tasks[:200]
+		}
+		result := []object{}
+		for _, task := range tasks {
+			result = append(result, taskKind.visible(task))
+		}
+		return http.StatusOK, result
+	}
+}
+
+// createTask starts a task. The fake cannot run tasks: they are waiting when
+// created and fail right away, with an output that says so.
+func (s *Server) createTask(req *request) (int, any) {
+	var task object
+	if err := decode(req, &task); err != nil {
+		return http.StatusBadRequest, errorBody("%s", err)
+	}
+	delete(task, "id")
+	template := s.projectObject(req, "templates", toInt64(task["template_id"]))
+	if template == nil {
+		return http.StatusBadRequest, errorBody("invalid template_id: object %d does not exist in project %d", toInt64(task["template_id"]), req.params["project_id"])
+	}
+	task["project_id"] = req.params["project_id"]
+	task["user_id"] = id(req.user)
+	task["created"] = now()
+	task["status"] = "error"
+	task = s.insert(expand(taskKind.collection, req.params), task)
+	task["output"] = []object{
+		{"task_id": id(task), "time": now(), "output": fmt.Sprintf("Task %d added to queue", id(task))},
+		{"task_id": id(task), "time": now(), "output": "The fake SemaphoreUI server does not run tasks"},
+	}
+	s.addEvent(req, "task", id(task), req.params["project_id"], fmt.Sprintf("task %d of template %v created", id(task), template["name"]))
+
+	created := taskKind.visible(task)
+	created["status"] = "waiting"
+	return http.StatusCreated, created
+}
+
+// stopTask stops a task. Tasks of the fake are always finished already.
+func (s *Server) stopTask(req *request) (int, any) {
+	if s.lookup(taskKind, req) == nil {
+		return http.StatusNotFound, errorBody("task not found")
+	}
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) taskOutput(req *request) (int, any) {
+	task := s.lookup(taskKind, req)
+	if task == nil {
+		return http.StatusNotFound, errorBody("task not found")
+	}
+	return http.StatusOK, task["output"]
+}
+
+func (s *Server) taskRawOutput(req *request) (int, any) {
+	task := s.lookup(taskKind, req)
+	if task == nil {
+		return http.StatusNotFound, errorBody("task not found")
+	}
+	var lines []string
+	for _, line := range task["output"].([]object) {
+		lines = append(lines, line["output"].(string))
+	}
+	return http.StatusOK, strings.Join(lines, "\n") + "\n"
+}
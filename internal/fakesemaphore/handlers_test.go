@@ -0,0 +1,214 @@
+package fakesemaphore
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestServer_templateEnvironment(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	project := "/project/" + itoa(projectID)
+	environmentID := testCreate(t, s, project+"/environment", map[string]any{"name": "Production"})
+	templateID := testCreate(t, s, project+"/templates", map[string]any{
+		"name":           "Deploy",
+		"playbook":       "deploy.yml",
+		"environment_id": environmentID,
+	})
+
+	// SemaphoreUI v2.16+ reads the legacy environment_id back as 0.
+	_, body := testRequest(t, s, http.MethodGet, project+"/templates/"+itoa(templateID), nil)
+	template := body.(map[string]any)
+	if template["environment_id"] != float64(0) {
+		t.Errorf("expected environment_id to read back as 0, got %v", template["environment_id"])
+	}
+	if ids, _ := template["environment_ids"].([]any); len(ids) != 1 || ids[0] != float64(environmentID) {
+		t.Errorf("expected environment_ids [%d], got %v", environmentID, template["environment_ids"])
+	}
+
+	status, body := testRequest(t, s, http.MethodPost, project+"/templates", map[string]any{"name": "No playbook"})
+	testExpectStatus(t, "POST template without playbook", status, http.StatusBadRequest, body)
+	if message := body.(map[string]any)["error"]; message != "template playbook can not be empty" {
+		t.Errorf("unexpected error message %v", message)
+	}
+}
+
+func TestServer_environmentSecrets(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	environments := "/project/" + itoa(projectID) + "/environment"
+	environmentID := testCreate(t, s, environments, map[string]any{
+		"name": "Production",
+		"secrets": []any{
+			map[string]any{"name": "TOKEN", "type": "env", "secret": "abc", "operation": "create"},
+			map[string]any{"name": "password", "type": "var", "secret": "def", "operation": "create"},
+		},
+	})
+
+	_, body := testRequest(t, s, http.MethodGet, environments+"/"+itoa(environmentID), nil)
+	secrets := body.(map[string]any)["secrets"].([]any)
+	if len(secrets) != 2 {
+		t.Fatalf("expected 2 secrets, got %v", secrets)
+	}
+	for _, secret := range secrets {
+		if value, ok := secret.(map[string]any)["secret"]; ok {
+			t.Errorf("expected the value of the secret not to be returned, got %v", value)
+		}
+	}
+
+	first := secrets[0].(map[string]any)
+	status, body := testRequest(t, s, http.MethodPut, environments+"/"+itoa(environmentID), map[string]any{
+		"name": "Production",
+		"secrets": []any{
+			map[string]any{"id": first["id"], "name": "API_TOKEN", "type": "env", "secret": "xyz", "operation": "update"},
+			map[string]any{"id": secrets[1].(map[string]any)["id"], "operation": "delete"},
+		},
+	})
+	testExpectStatus(t, "PUT environment", status, http.StatusNoContent, body)
+
+	_, body = testRequest(t, s, http.MethodGet, environments+"/"+itoa(environmentID), nil)
+	secrets = body.(map[string]any)["secrets"].([]any)
+	if len(secrets) != 1 || secrets[0].(map[string]any)["name"] != "API_TOKEN" || secrets[0].(map[string]any)["id"] != first["id"] {
+		t.Errorf("expected the secret operations to be applied, got %v", secrets)
+	}
+}
+
+func TestServer_projectUsers(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	users := "/project/" + itoa(projectID) + "/users"
+	userID := testCreate(t, s, "/users", map[string]any{"username": "jane", "name": "Jane", "email": "jane@example.com", "password": "secret"})
+
+	status, body := testRequest(t, s, http.MethodPost, "/users", map[string]any{"username": "jane", "name": "Jane", "email": "other@example.com"})
+	testExpectStatus(t, "POST duplicate user", status, http.StatusBadRequest, body)
+
+	status, body = testRequest(t, s, http.MethodPost, users, map[string]any{"user_id": userID, "role": "manager"})
+	testExpectStatus(t, "POST project user", status, http.StatusNoContent, body)
+	status, body = testRequest(t, s, http.MethodPut, users+"/"+itoa(userID), map[string]any{"role": "guest"})
+	testExpectStatus(t, "PUT project user", status, http.StatusNoContent, body)
+
+	// The creator of the project is its owner.
+	_, body = testRequest(t, s, http.MethodGet, users, nil)
+	members := body.([]any)
+	if len(members) != 2 || members[0].(map[string]any)["role"] != "owner" || members[1].(map[string]any)["role"] != "guest" {
+		t.Errorf("expected the owner and the guest, got %v", members)
+	}
+	if members[1].(map[string]any)["username"] != "jane" {
+		t.Errorf("expected the username of the member, got %v", members[1])
+	}
+
+	status, body = testRequest(t, s, http.MethodDelete, "/users/"+itoa(userID), nil)
+	testExpectStatus(t, "DELETE user", status, http.StatusNoContent, body)
+	if _, body = testRequest(t, s, http.MethodGet, users, nil); len(body.([]any)) != 1 {
+		t.Errorf("expected the deleted user to leave the project, got %v", body)
+	}
+}
+
+func TestServer_tasks(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	project := "/project/" + itoa(projectID)
+	templateID := testCreate(t, s, project+"/templates", map[string]any{"name": "Deploy", "playbook": "deploy.yml"})
+
+	status, body := testRequest(t, s, http.MethodPost, project+"/tasks", map[string]any{"template_id": templateID, "message": "Run"})
+	testExpectStatus(t, "POST task", status, http.StatusCreated, body)
+	task := body.(map[string]any)
+	if task["status"] != "waiting" {
+		t.Errorf("expected a new task to be waiting, got %v", task["status"])
+	}
+	taskPath := project + "/tasks/" + itoa(int64(task["id"].(float64)))
+
+	_, body = testRequest(t, s, http.MethodGet, taskPath, nil)
+	if status := body.(map[string]any)["status"]; status != "error" {
+		t.Errorf("expected the task to fail, got %v", status)
+	}
+	_, body = testRequest(t, s, http.MethodGet, project+"/tasks/last", nil)
+	if tasks := body.([]any); len(tasks) != 1 || tasks[0].(map[string]any)["message"] != "Run" {
+		t.Errorf("expected the task in the last tasks, got %v", tasks)
+	}
+	_, body = testRequest(t, s, http.MethodGet, taskPath+"/raw_output", nil)
+	if output, _ := body.(string); !strings.Contains(output, "does not run tasks") {
+		t.Errorf("expected the raw output to explain the failure, got %v", body)
+	}
+
+	status, body = testRequest(t, s, http.MethodPost, project+"/tasks", map[string]any{"template_id": 999})
+	testExpectStatus(t, "POST task of a missing template", status, http.StatusBadRequest, body)
+}
+
+func TestServer_integrationAliases(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	integrations := "/project/" + itoa(projectID) + "/integrations"
+	templateID := testCreate(t, s, "/project/"+itoa(projectID)+"/templates", map[string]any{"name": "Deploy", "playbook": "deploy.yml"})
+	integrationID := testCreate(t, s, integrations, map[string]any{"name": "GitHub", "template_id": templateID})
+
+	status, body := testRequest(t, s, http.MethodPost, integrations+"/aliases", nil)
+	testExpectStatus(t, "POST project alias", status, http.StatusOK, body)
+	if url, _ := body.(map[string]any)["url"].(string); !strings.HasPrefix(url, s.URL+"/api/integrations/") {
+		t.Errorf("expected the URL of the alias, got %v", body)
+	}
+	testCreate(t, s, integrations+"/"+itoa(integrationID)+"/aliases", nil)
+
+	_, body = testRequest(t, s, http.MethodGet, integrations+"/aliases", nil)
+	if aliases := body.([]any); len(aliases) != 1 {
+		t.Errorf("expected the project alias only, got %v", aliases)
+	}
+
+	status, body = testRequest(t, s, http.MethodDelete, integrations+"/"+itoa(integrationID), nil)
+	testExpectStatus(t, "DELETE integration", status, http.StatusNoContent, body)
+	if status, _ := testRequest(t, s, http.MethodGet, integrations+"/"+itoa(integrationID)+"/aliases", nil); status != http.StatusNotFound {
+		t.Errorf("expected 404 for the aliases of the deleted integration, got %d", status)
+	}
+}
+
+func TestServer_projectInvites(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+	invites := "/project/" + itoa(projectID) + "/invites"
+	userID := testCreate(t, s, "/users", map[string]any{"username": "jane", "name": "Jane", "email": "jane@example.com"})
+	testRequest(t, s, http.MethodPost, "/project/"+itoa(projectID)+"/users", map[string]any{"user_id": userID, "role": "guest"})
+
+	status, body := testRequest(t, s, http.MethodPost, invites, map[string]any{"email": "john@example.com", "role": "manager"})
+	testExpectStatus(t, "POST invite", status, http.StatusCreated, body)
+	invite := body.(map[string]any)
+	if invite["status"] != "pending" || invite["expires_at"] == nil || invite["inviter_user_id"] == nil {
+		t.Errorf("expected a pending invite with an expiry and an inviter, got %v", invite)
+	}
+	invitePath := invites + "/" + itoa(int64(invite["id"].(float64)))
+
+	status, body = testRequest(t, s, http.MethodPost, invites, map[string]any{"email": "john@example.com", "role": "guest"})
+	testExpectStatus(t, "POST duplicate invite", status, http.StatusConflict, body)
+	status, body = testRequest(t, s, http.MethodPost, invites, map[string]any{"email": "jane@example.com", "role": "guest"})
+	testExpectStatus(t, "POST invite of a member", status, http.StatusConflict, body)
+	status, body = testRequest(t, s, http.MethodPost, invites, map[string]any{"email": "joe@example.com", "role": "admin"})
+	testExpectStatus(t, "POST invite with an invalid role", status, http.StatusBadRequest, body)
+
+	status, body = testRequest(t, s, http.MethodPut, invitePath, map[string]any{"status": "accepted"})
+	testExpectStatus(t, "PUT invite accepted", status, http.StatusBadRequest, body)
+	status, body = testRequest(t, s, http.MethodPut, invitePath, map[string]any{"status": "declined"})
+	testExpectStatus(t, "PUT invite declined", status, http.StatusNoContent, body)
+	if _, body = testRequest(t, s, http.MethodGet, invitePath, nil); body.(map[string]any)["status"] != "declined" {
+		t.Errorf("expected the invite to be declined, got %v", body)
+	}
+
+	// A declined invite no longer blocks a new invite of the same email.
+	testCreate(t, s, invites, map[string]any{"email": "john@example.com", "role": "guest"})
+	status, body = testRequest(t, s, http.MethodDelete, invitePath, nil)
+	testExpectStatus(t, "DELETE invite", status, http.StatusNoContent, body)
+	if _, body = testRequest(t, s, http.MethodGet, invites, nil); len(body.([]any)) != 1 {
+		t.Errorf("expected the new invite only, got %v", body)
+	}
+}
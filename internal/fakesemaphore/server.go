@@ -0,0 +1,367 @@
+// Package fakesemaphore implements an in-memory fake of the SemaphoreUI API,
+// so that the provider can be tested without a SemaphoreUI server.
+//
+// The fake serves the paths of api-docs.yml that the provider uses, and
+// reproduces the behavior of SemaphoreUI the provider depends on: secrets are
+// accepted but never returned, the legacy environment_id of templates reads
+// back as 0, references to objects that do not exist are rejected, and tasks
+// are not run but fail right away.
+package fakesemaphore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Version is the SemaphoreUI version reported by the fake.
+	Version = "v2.16.18"
+
+	// Token is an API token of the admin user that the fake always accepts.
+	Token = "fakesemaphore-admin-token"
+
+	// AdminUsername and AdminPassword are the credentials of the admin user,
+	// which exists from the start like on a new SemaphoreUI instance.
+	AdminUsername = "admin"
+	AdminPassword = "admin"
+
+	// sessionCookie is the name of the session cookie set by a login.
+	sessionCookie = "semaphore"
+)
+
+// object is a stored object, as the JSON object that the API returns.
+type object = map[string]any
+
+// request is an authenticated API request, with the parameters of its path.
+// params holds the numeric ones, and values all of them as given. header is
+// the header of the response.
+type request struct {
+	*http.Request
+	params map[string]int64
+	values map[string]string
+	user   object
+	header http.Header
+}
+
+// handler handles a request and returns the status code and body of the
+// response. A nil body is an empty response, a string is plain text, and
+// anything else is returned as JSON.
+type handler func(req *request) (int, any)
+
+type route struct {
+	method  string
+	regex   *regexp.Regexp
+	names   []string
+	handler handler
+	public  bool
+}
+
+// routeParamRegex matches the parameters of route patterns, e.g. {project_id}.
+var routeParamRegex = regexp.MustCompile(`\{(\w+)\}`)
+
+// stringParams are the route parameters that are not numeric IDs.
+var stringParams = map[string]bool{
+	"api_token_id": true,
+}
+
+// Server is a fake SemaphoreUI API server. Its state is kept in memory and
+// lost when the server is closed.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	routes []route
+
+	// lastID is the last ID given to an object. IDs are unique across
+	// collections, which keeps mix-ups between IDs of different objects
+	// from going unnoticed.
+	lastID int64
+
+	// objects holds the objects of each collection by ID, keyed by the path
+	// of the collection, e.g. /project/1/keys.
+	objects map[string]map[int64]object
+
+	// passwords holds the passwords of the users by user ID.
+	passwords map[int64]string
+
+	// tokens and sessions map API tokens and session cookies to user IDs.
+	tokens   map[string]*apiToken
+	sessions map[string]int64
+
+	// events are the events of the event log, the newest first.
+	events []object
+}
+
+type apiToken struct {
+	id      string
+	created string
+	expired bool
+	userID  int64
+}
+
+// New starts a fake SemaphoreUI API server. The API is served under /api, see
+// APIURL. Close the server when done.
+func New() *Server {
+	s := &Server{
+		objects:   make(map[string]map[int64]object),
+		passwords: make(map[int64]string),
+		tokens:    make(map[string]*apiToken),
+		sessions:  make(map[string]int64),
+	}
+	s.registerRoutes()
+
+	admin := s.insert("/users", object{
+		"username": AdminUsername,
+		"name":     "Admin",
+		"email":    "admin@localhost",
+		"admin":    true,
+		"alert":    false,
+		"external": false,
+		"created":  now(),
+	})
+	s.passwords[id(admin)] = AdminPassword
+	s.tokens[Token] = &apiToken{id: Token, created: now(), userID: id(admin)}
+
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// APIURL returns the base URL of the API, as configured in the provider.
+func (s *Server) APIURL() string {
+	return s.URL + "/api"
+}
+
+// handle registers the handler of the requests to an API path. Parameters
+// in the pattern, e.g. {project_id}, match numeric IDs, except stringParams.
+func (s *Server) handle(method, pattern string, h handler) {
+	s.routes = append(s.routes, newRoute(method, pattern, h))
+}
+
+// handlePublic registers a handler that does not require authentication.
+func (s *Server) handlePublic(method, pattern string, h handler) {
+	r := newRoute(method, pattern, h)
+	r.public = true
+	s.routes = append(s.routes, r)
+}
+
+func newRoute(method, pattern string, h handler) route {
+	var names []string
+	expression := routeParamRegex.ReplaceAllStringFunc(pattern, func(param string) string {
+		name := strings.Trim(param, "{}")
+		names = append(names, name)
+		if stringParams[name] {
+			return `([^/]+)`
+		}
+		return `(\d+)`
+	})
+	regex := regexp.MustCompile("^" + expression + "$")
+	return route{method: method, regex: regex, names: names, handler: h}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, ok := strings.CutPrefix(r.URL.Path, "/api/")
+	if !ok {
+		writeResponse(w, http.StatusNotFound, errorBody("not found"))
+		return
+	}
+	// Some paths of the API end with a slash, e.g. /project/{project_id}/.
+	path = "/" + strings.TrimSuffix(path, "/")
+
+	methodAllowed := true
+	for _, rt := range s.routes {
+		match := rt.regex.FindStringSubmatch(path)
+		if match == nil {
+			continue
+		}
+		if rt.method != r.Method {
+			methodAllowed = false
+			continue
+		}
+
+		req := &request{Request: r, params: make(map[string]int64), values: make(map[string]string), header: w.Header()}
+		for i, name := range rt.names {
+			req.values[name] = match[i+1]
+			if !stringParams[name] {
+				req.params[name], _ = strconv.ParseInt(match[i+1], 10, 64)
+			}
+		}
+		if !rt.public {
+			req.user = s.authenticate(r)
+			if req.user == nil {
+				writeResponse(w, http.StatusUnauthorized, errorBody("unauthorized"))
+				return
+			}
+			if status, body, ok := s.checkParents(req); !ok {
+				writeResponse(w, status, body)
+				return
+			}
+		}
+		status, body := rt.handler(req)
+		writeResponse(w, status, body)
+		return
+	}
+
+	if !methodAllowed {
+		writeResponse(w, http.StatusMethodNotAllowed, errorBody("method not allowed"))
+		return
+	}
+	writeResponse(w, http.StatusNotFound, errorBody("not found"))
+}
+
+// authenticate returns the user of the API token or session cookie of the
+// request, or nil.
+func (s *Server) authenticate(r *http.Request) object {
+	var userID int64
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		t, ok := s.tokens[strings.TrimSpace(token)]
+		if !ok || t.expired {
+			return nil
+		}
+		userID = t.userID
+	} else if cookie, err := r.Cookie(sessionCookie); err == nil {
+		userID = s.sessions[cookie.Value]
+	}
+	if userID == 0 {
+		return nil
+	}
+	return s.objects["/users"][userID]
+}
+
+// checkParents checks that the project and integration of a request exist,
+// and that its user is a member of the project.
+func (s *Server) checkParents(req *request) (int, any, bool) {
+	projectID, ok := req.params["project_id"]
+	if !ok {
+		return 0, nil, true
+	}
+	if s.objects["/projects"][projectID] == nil {
+		return http.StatusNotFound, errorBody("project not found"), false
+	}
+	if !isAdmin(req.user) && s.objects[fmt.Sprintf("/project/%d/users", projectID)][id(req.user)] == nil {
+		return http.StatusForbidden, errorBody("you are not a member of this project"), false
+	}
+	if integrationID, ok := req.params["integration_id"]; ok {
+		if s.objects[fmt.Sprintf("/project/%d/integrations", projectID)][integrationID] == nil {
+			return http.StatusNotFound, errorBody("integration not found"), false
+		}
+	}
+	return 0, nil, true
+}
+
+// insert stores a new object in a collection and returns it.
+func (s *Server) insert(collection string, o object) object {
+	s.lastID++
+	o["id"] = s.lastID
+	if s.objects[collection] == nil {
+		s.objects[collection] = make(map[int64]object)
+	}
+	s.objects[collection][s.lastID] = o
+	return o
+}
+
+// deleteTree deletes an object and the collections below it, e.g. the keys
+// of a project.
+func (s *Server) deleteTree(collection string, objectPath string, objectID int64) {
+	delete(s.objects[collection], objectID)
+	for path := range s.objects {
+		if strings.HasPrefix(path, objectPath+"/") {
+			delete(s.objects, path)
+		}
+	}
+}
+
+// addEvent records an event of the event log.
+func (s *Server) addEvent(req *request, objectType string, objectID, projectID int64, description string) {
+	event := object{
+		"created":     now(),
+		"description": description,
+		"object_id":   objectID,
+		"object_type": objectType,
+		"user_id":     id(req.user),
+		"username":    req.user["username"],
+	}
+	if projectID != 0 {
+		event["project_id"] = projectID
+	}
+	s.events = append([]object{event}, s.events...)
+}
+
+// decode decodes the JSON body of a request.
+func decode(req *request, v any) error {
+	decoder := json.NewDecoder(req.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, status int, body any) {
+	switch body := body.(type) {
+	case nil:
+		w.WriteHeader(status)
+	case string:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+// errorBody returns the body of an error response, like SemaphoreUI does.
+func errorBody(format string, args ...any) object {
+	return object{"error": fmt.Sprintf(format, args...)}
+}
+
+// toInt64 returns the integer value of a JSON number, or 0.
+func toInt64(v any) int64 {
+	switch v := v.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	case json.Number:
+		i, _ := v.Int64()
+		return i
+	}
+	return 0
+}
+
+// id returns the ID of an object.
+func id(o object) int64 {
+	return toInt64(o["id"])
+}
+
+func isAdmin(user object) bool {
+	admin, _ := user["admin"].(bool)
+	return admin
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// randomString returns a random hex string, e.g. for tokens.
+func randomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,179 @@
+package fakesemaphore
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// testRequest sends a request to the API of the server with the admin token
+// and returns the status code and decoded body of the response.
+func testRequest(t *testing.T, s *Server, method, path string, body any) (int, any) {
+	t.Helper()
+	return testRequestWith(t, s.Client(), s, method, path, body, Token)
+}
+
+func testRequestWith(t *testing.T, client *http.Client, s *Server, method, path string, body any, token string) (int, any) {
+	t.Helper()
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("could not encode request body: %s", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, s.APIURL()+path, reader)
+	if err != nil {
+		t.Fatalf("could not create request: %s", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %s", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("could not read response body: %s", err)
+	}
+	if len(data) == 0 {
+		return resp.StatusCode, nil
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return resp.StatusCode, string(data)
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("could not decode response body %q: %s", data, err)
+	}
+	return resp.StatusCode, decoded
+}
+
+// testCreate creates an object and returns its ID.
+func testCreate(t *testing.T, s *Server, path string, body any) int64 {
+	t.Helper()
+	status, response := testRequest(t, s, http.MethodPost, path, body)
+	if status != http.StatusCreated && status != http.StatusOK {
+		t.Fatalf("POST %s returned %d: %v", path, status, response)
+	}
+	return int64(response.(map[string]any)["id"].(float64))
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
+
+// testExpectStatus fails the test when the status code is not the expected
+// one.
+func testExpectStatus(t *testing.T, operation string, status, expected int, body any) {
+	t.Helper()
+	if status != expected {
+		t.Fatalf("%s returned %d, expected %d: %v", operation, status, expected, body)
+	}
+}
+
+func TestServer_authentication(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	if status, body := testRequestWith(t, s.Client(), s, http.MethodGet, "/ping", nil, ""); status != http.StatusOK || body != "pong" {
+		t.Errorf("expected ping to answer without authentication, got %d %v", status, body)
+	}
+	if status, _ := testRequestWith(t, s.Client(), s, http.MethodGet, "/info", nil, ""); status != http.StatusUnauthorized {
+		t.Errorf("expected 401 without credentials, got %d", status)
+	}
+	if status, _ := testRequestWith(t, s.Client(), s, http.MethodGet, "/info", nil, "wrong"); status != http.StatusUnauthorized {
+		t.Errorf("expected 401 with an unknown token, got %d", status)
+	}
+
+	status, body := testRequest(t, s, http.MethodGet, "/info", nil)
+	testExpectStatus(t, "GET /info", status, http.StatusOK, body)
+	if version := body.(map[string]any)["version"]; version != Version {
+		t.Errorf("expected version %s, got %v", Version, version)
+	}
+}
+
+func TestServer_login(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	jar, _ := cookiejar.New(nil)
+	client := s.Client()
+	client.Jar = jar
+
+	status, body := testRequestWith(t, client, s, http.MethodPost, "/auth/login", map[string]string{"auth": AdminUsername, "password": "wrong"}, "")
+	testExpectStatus(t, "POST /auth/login with a wrong password", status, http.StatusUnauthorized, body)
+
+	status, body = testRequestWith(t, client, s, http.MethodPost, "/auth/login", map[string]string{"auth": AdminUsername, "password": AdminPassword}, "")
+	testExpectStatus(t, "POST /auth/login", status, http.StatusNoContent, body)
+
+	status, body = testRequestWith(t, client, s, http.MethodGet, "/user/", nil, "")
+	testExpectStatus(t, "GET /user/ with the session", status, http.StatusOK, body)
+	if username := body.(map[string]any)["username"]; username != AdminUsername {
+		t.Errorf("expected the session of %s, got %v", AdminUsername, username)
+	}
+
+	status, body = testRequestWith(t, client, s, http.MethodPost, "/auth/logout", nil, "")
+	testExpectStatus(t, "POST /auth/logout", status, http.StatusNoContent, body)
+	if status, _ := testRequestWith(t, client, s, http.MethodGet, "/user/", nil, ""); status != http.StatusUnauthorized {
+		t.Errorf("expected 401 after logout, got %d", status)
+	}
+}
+
+func TestServer_apiTokens(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := testRequest(t, s, http.MethodPost, "/user/tokens", nil)
+	testExpectStatus(t, "POST /user/tokens", status, http.StatusCreated, body)
+	token := body.(map[string]any)["id"].(string)
+
+	if status, _ := testRequestWith(t, s.Client(), s, http.MethodGet, "/projects", nil, token); status != http.StatusOK {
+		t.Errorf("expected the new token to be accepted, got %d", status)
+	}
+
+	status, body = testRequest(t, s, http.MethodDelete, "/user/tokens/"+token, nil)
+	testExpectStatus(t, "DELETE /user/tokens/{api_token_id}", status, http.StatusNoContent, body)
+	if status, _ := testRequestWith(t, s.Client(), s, http.MethodGet, "/projects", nil, token); status != http.StatusUnauthorized {
+		t.Errorf("expected the expired token to be rejected, got %d", status)
+	}
+
+	_, body = testRequest(t, s, http.MethodGet, "/user/tokens", nil)
+	tokens := body.([]any)
+	if len(tokens) != 1 || tokens[0].(map[string]any)["expired"] != true {
+		t.Errorf("expected the expired token to be listed, got %v", tokens)
+	}
+}
+
+func TestServer_routing(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	projectID := testCreate(t, s, "/projects", map[string]any{"name": "Infra"})
+
+	// Some paths of the API end with a slash, others do not.
+	for _, path := range []string{"/project/" + itoa(projectID) + "/", "/project/" + itoa(projectID)} {
+		if status, _ := testRequest(t, s, http.MethodGet, path, nil); status != http.StatusOK {
+			t.Errorf("expected GET %s to return 200, got %d", path, status)
+		}
+	}
+
+	if status, _ := testRequest(t, s, http.MethodGet, "/project/999/keys", nil); status != http.StatusNotFound {
+		t.Errorf("expected 404 for the objects of a missing project, got %d", status)
+	}
+	if status, _ := testRequest(t, s, http.MethodGet, "/unknown", nil); status != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown path, got %d", status)
+	}
+	if status, _ := testRequest(t, s, http.MethodPatch, "/projects", nil); status != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405 for an unknown method, got %d", status)
+	}
+}
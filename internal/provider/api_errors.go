@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// maxAPIErrorMessageLength limits the length of plain text error messages
+// taken from responses, e.g. HTML error pages of a reverse proxy.
+const maxAPIErrorMessageLength = 500
+
+// apiErrorFieldRegex matches the API field names mentioned in error messages.
+var apiErrorFieldRegex = regexp.MustCompile(`\b[a-z]+(?:_[a-z]+)+\b`)
+
+// apiErrorFieldAttributes maps the API fields that error messages mention to
+// the attributes they are configured with.
+var apiErrorFieldAttributes = map[string]string{
+	"become_key_id":     "become_key_id",
+	"build_template_id": "build_template_id",
+	"cron_format":       "cron_format",
+	"environment_id":    "environment_id",
+	"git_url":           "url",
+	"inventory_id":      "inventory_id",
+	"repository_id":     "repository_id",
+	"run_at":            "run_at",
+	"ssh_key_id":        "ssh_key_id",
+	"template_id":       "template_id",
+	"view_id":           "view_id",
+}
+
+// apiErrorMessageAttributes maps well-known validation messages of the
+// SemaphoreUI API, which describe fields in words, to their attributes.
+var apiErrorMessageAttributes = []struct {
+	regex     *regexp.Regexp
+	attribute string
+}{
+	{regexp.MustCompile(`(?i)\btemplate playbook\b`), "playbook"},
+	{regexp.MustCompile(`(?i)\btemplate inventory\b`), "inventory_id"},
+	{regexp.MustCompile(`(?i)\btemplate repository\b`), "repository_id"},
+	{regexp.MustCompile(`(?i)\btemplate environment\b`), "environment_id"},
+	{regexp.MustCompile(`(?i)\bcron\b`), "cron_format"},
+	{regexp.MustCompile(`(?i)\bname (can ?not|must not|should not) be empty\b`), "name"},
+}
+
+// apiError is a failed response of the SemaphoreUI API, with the message
+// returned by the server and the ID of the request. It wraps the error of the
+// generated client, so that typed responses such as *XxxNotFound and
+// *runtime.APIError can still be matched with errors.As.
+type apiError struct {
+	err        error
+	operation  string
+	statusCode int
+	message    string
+	requestID  string
+}
+
+func (e *apiError) Error() string {
+	text := fmt.Sprintf("%s returned %d %s", e.operation, e.statusCode, http.StatusText(e.statusCode))
+	if e.message != "" {
+		text += ": " + e.message
+	}
+	if e.requestID != "" {
+		text += " (request ID: " + e.requestID + ")"
+	}
+	return text
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+// apiErrorMessage returns the message of an error response: the error field
+// of a JSON body, or a plain text body.
+func apiErrorMessage(body []byte) string {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return ""
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal(body, &object); err == nil {
+		for _, key := range []string{"error", "message"} {
+			if message, ok := object[key].(string); ok {
+				return strings.TrimSpace(message)
+			}
+		}
+		return ""
+	}
+	if json.Valid(body) {
+		return ""
+	}
+
+	message := string(body)
+	if len(message) > maxAPIErrorMessageLength {
+		message = message[:maxAPIErrorMessageLength] + "..."
+	}
+	return message
+}
+
+// bufferedClientResponse is a response whose body has already been read, so
+// that it can be read again by the generated client.
+type bufferedClientResponse struct {
+	runtime.ClientResponse
+	body []byte
+}
+
+func (r bufferedClientResponse) Body() io.ReadCloser {
+	return io.NopCloser(bytes.NewReader(r.body))
+}
+
+// apiErrorRuntime turns the errors of failed API responses into *apiError,
+// as the generated client neither reads the message of the server nor keeps
+// the request ID.
+type apiErrorRuntime struct {
+	runtime.ContextualTransport
+}
+
+func (t apiErrorRuntime) withAPIErrors(operation *runtime.ClientOperation) *runtime.ClientOperation {
+	reader := operation.Reader
+	name := operation.Method + " " + operation.PathPattern
+	operation.Reader = runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+		if response.Code() < http.StatusBadRequest {
+			return reader.ReadResponse(response, consumer)
+		}
+
+		body, _ := io.ReadAll(response.Body())
+		result, err := reader.ReadResponse(bufferedClientResponse{ClientResponse: response, body: body}, consumer)
+		if err == nil {
+			return result, nil
+		}
+		return result, &apiError{
+			err:        err,
+			operation:  name,
+			statusCode: response.Code(),
+			message:    apiErrorMessage(body),
+			requestID:  response.GetHeader(requestIDHeader),
+		}
+	})
+	return operation
+}
+
+func (t apiErrorRuntime) Submit(operation *runtime.ClientOperation) (any, error) {
+	return t.ContextualTransport.Submit(t.withAPIErrors(operation))
+}
+
+func (t apiErrorRuntime) SubmitContext(ctx context.Context, operation *runtime.ClientOperation) (any, error) {
+	return t.ContextualTransport.SubmitContext(ctx, t.withAPIErrors(operation))
+}
+
+// errNotFound is wrapped by the errors of lookups that did not find an object
+// in a list returned by the API, so that isNotFound recognizes them.
+var errNotFound = errors.New("not found")
+
+// isNotFound reports whether err means that the object does not exist: an
+// HTTP 404 returned by the SemaphoreUI API, or a lookup that did not find the
+// object. Read removes such objects from the state so that they are created
+// again, and Delete treats them as already deleted.
+func isNotFound(err error) bool {
+	if errors.Is(err, errNotFound) {
+		return true
+	}
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr.statusCode == http.StatusNotFound
+	}
+	var clientErr *runtime.APIError
+	if errors.As(err, &clientErr) {
+		return clientErr.Code == http.StatusNotFound
+	}
+	var codeErr interface{ Code() int }
+	return errors.As(err, &codeErr) && codeErr.Code() == http.StatusNotFound
+}
+
+// apiErrorAttribute returns the attribute a rejected request failed on, when
+// the message of the server names it.
+func apiErrorAttribute(err *apiError) string {
+	if err.statusCode != http.StatusBadRequest && err.statusCode != http.StatusUnprocessableEntity {
+		return ""
+	}
+	for _, field := range apiErrorFieldRegex.FindAllString(err.message, -1) {
+		if attribute, ok := apiErrorFieldAttributes[field]; ok {
+			return attribute
+		}
+	}
+	for _, known := range apiErrorMessageAttributes {
+		if known.regex.MatchString(err.message) {
+			return known.attribute
+		}
+	}
+	return ""
+}
+
+// apiErrorDiagnostics translates an error of the SemaphoreUI API into a
+// diagnostic with the message of the server, a hint for well-known statuses,
+// and the attribute the request failed on when the message names it. detail
+// describes what could not be done, e.g. "Could not create project".
+func apiErrorDiagnostics(summary, detail string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if detail != "" {
+		detail += ": "
+	}
+	detail += err.Error()
+
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, detail)
+		return diags
+	}
+
+	switch apiErr.statusCode {
+	case http.StatusUnauthorized:
+		detail += "\n\nSemaphoreUI rejected the credentials of the provider. Check that the API token, or the username and password, are valid."
+	case http.StatusForbidden:
+		detail += "\n\nThe user of the provider is not allowed to do this. Check the role of the user in the project, or whether the user must be an admin."
+	case http.StatusNotFound:
+		detail += "\n\nThe object does not exist. It may have been deleted outside of Terraform."
+	}
+
+	if attribute := apiErrorAttribute(apiErr); attribute != "" {
+		diags.AddAttributeError(path.Root(attribute), summary, detail)
+		return diags
+	}
+	diags.AddError(summary, detail)
+	return diags
+}
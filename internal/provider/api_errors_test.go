@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+)
+
+func TestAPIErrorMessage(t *testing.T) {
+	tests := map[string]string{
+		``: "",
+		`{"error":"template playbook can not be empty"}`: "template playbook can not be empty",
+		`{"message":" Invalid cron format "}`:            "Invalid cron format",
+		`{"id":1}`:                                       "",
+		`"quoted"`:                                       "",
+		"Not Found\n":                                    "Not Found",
+	}
+	for body, expected := range tests {
+		if got := apiErrorMessage([]byte(body)); got != expected {
+			t.Errorf("apiErrorMessage(%q) = %q, expected %q", body, got, expected)
+		}
+	}
+}
+
+func TestAPIErrorAttribute(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		message    string
+		expected   string
+	}{
+		{http.StatusBadRequest, "invalid ssh_key_id", "ssh_key_id"},
+		{http.StatusBadRequest, "git_url must be a valid URL", "url"},
+		{http.StatusBadRequest, "template playbook can not be empty", "playbook"},
+		{http.StatusBadRequest, "Template name can not be empty", "name"},
+		{http.StatusBadRequest, "unknown failure", ""},
+		{http.StatusInternalServerError, "invalid ssh_key_id", ""},
+	}
+	for _, test := range tests {
+		err := &apiError{statusCode: test.statusCode, message: test.message}
+		if got := apiErrorAttribute(err); got != test.expected {
+			t.Errorf("apiErrorAttribute(%d, %q) = %q, expected %q", test.statusCode, test.message, got, test.expected)
+		}
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	cause := runtime.NewAPIError("[POST /project/{project_id}/templates] PostProjectProjectIDTemplates", nil, http.StatusBadRequest)
+	err := error(&apiError{
+		err:        cause,
+		operation:  "POST /project/{project_id}/templates",
+		statusCode: http.StatusBadRequest,
+		message:    "template playbook can not be empty",
+		requestID:  "abc",
+	})
+
+	expected := "POST /project/{project_id}/templates returned 400 Bad Request: template playbook can not be empty (request ID: abc)"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+
+	var apiErr *runtime.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusBadRequest {
+		t.Error("expected the error of the generated client to be wrapped")
+	}
+}
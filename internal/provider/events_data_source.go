@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/operations"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &eventsDataSource{}
+)
+
+func NewEventsDataSource() datasource.DataSource {
+	return &eventsDataSource{}
+}
+
+type eventsDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *eventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *eventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+// Schema defines the schema for the data source.
+func (d *eventsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = EventsSchema().GetDataSource(ctx)
+}
+
+// filterEvents returns the events matching the filters, oldest first. Unset
+// (null) filters match every event. When last is set, only that many of the
+// most recent matching events are returned. The events are filtered and
+// truncated here rather than read from /events/last: that endpoint returns the
+// last 200 events before any filter, so fewer than last events could match.
+func filterEvents(events []*models.Event, objectType types.String, objectID types.Int64, userID types.Int64, last types.Int64) ([]EventModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	type matchingEvent struct {
+		event   *models.Event
+		created time.Time
+	}
+	var matching []matchingEvent
+	for _, event := range events {
+		if !objectType.IsNull() && event.ObjectType != objectType.ValueString() {
+			continue
+		}
+		if !objectID.IsNull() && event.ObjectID != objectID.ValueInt64() {
+			continue
+		}
+		if !userID.IsNull() && event.UserID != userID.ValueInt64() {
+			continue
+		}
+		created, err := time.Parse(time.RFC3339Nano, event.Created)
+		if err != nil {
+			diags.AddError(
+				"Invalid SemaphoreUI Event",
+				fmt.Sprintf("Could not parse the creation time %q of event %q: %s", event.Created, event.Description, err),
+			)
+			continue
+		}
+		matching = append(matching, matchingEvent{event: event, created: created})
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The API does not guarantee the order of events, so sort them by the
+	// time they occurred.
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].created.Before(matching[j].created)
+	})
+
+	if !last.IsNull() && int64(len(matching)) > last.ValueInt64() {
+		matching = matching[int64(len(matching))-last.ValueInt64():]
+	}
+
+	result := []EventModel{}
+	for _, m := range matching {
+		event := m.event
+		result = append(result, EventModel{
+			ProjectID:   types.Int64Value(event.ProjectID),
+			UserID:      types.Int64Value(event.UserID),
+			Username:    types.StringValue(event.Username),
+			ObjectType:  types.StringValue(event.ObjectType),
+			ObjectID:    types.Int64Value(event.ObjectID),
+			Description: types.StringValue(event.Description),
+			Created:     types.StringValue(event.Created),
+		})
+	}
+	return result, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *eventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EventsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Operations.GetEvents(&operations.GetEventsParams{}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Events", "Could not read events", err)...)
+		return
+	}
+
+	events, diags := filterEvents(response.Payload, config.ObjectType, config.ObjectID, config.UserID, config.Last)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Events = events
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccEventsDataSourceConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "Test %[1]s"
+}
+
+data "semaphoreui_events" "test" {
+  object_type = "project"
+  last        = 1
+
+  depends_on = [semaphoreui_project.test]
+}
+`, nameSuffix)
+}
+
+func TestAcc_EventsDataSource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_events.test", "events.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_events.test", "events.0.object_type", "project"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_events.test", "events.0.project_id", "semaphoreui_project.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterEvents(t *testing.T) {
+	events := []*models.Event{
+		{ObjectType: "task", Description: "third", Created: "2025-01-31T22:00:02Z"},
+		{ObjectType: "project", Description: "first", Created: "2025-01-31T22:00:00Z"},
+		{ObjectType: "task", Description: "second", Created: "2025-01-31T22:00:01.5+00:00"},
+		{ObjectType: "task", Description: "fourth", Created: "2025-01-31T23:00:00+01:00"},
+	}
+
+	result, diags := filterEvents(events, types.StringValue("task"), types.Int64Null(), types.Int64Null(), types.Int64Value(2))
+	if diags.HasError() {
+		t.Fatalf("filterEvents returned unexpected errors: %v", diags)
+	}
+	var descriptions []string
+	for _, event := range result {
+		descriptions = append(descriptions, event.Description.ValueString())
+	}
+	if fmt.Sprint(descriptions) != "[second third]" {
+		t.Errorf("expected the last 2 task events oldest first, got %v", descriptions)
+	}
+
+	events = append(events, &models.Event{ObjectType: "task", Description: "broken", Created: "yesterday"})
+	if _, diags := filterEvents(events, types.StringNull(), types.Int64Null(), types.Int64Null(), types.Int64Null()); !diags.HasError() {
+		t.Error("filterEvents expected an error for an invalid creation time")
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type EventsModel struct {
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectID   types.Int64  `tfsdk:"object_id"`
+	UserID     types.Int64  `tfsdk:"user_id"`
+	Last       types.Int64  `tfsdk:"last"`
+	Events     []EventModel `tfsdk:"events"`
+}
+
+type ProjectEventsModel struct {
+	ProjectID  types.Int64  `tfsdk:"project_id"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectID   types.Int64  `tfsdk:"object_id"`
+	UserID     types.Int64  `tfsdk:"user_id"`
+	Last       types.Int64  `tfsdk:"last"`
+	Events     []EventModel `tfsdk:"events"`
+}
+
+type EventModel struct {
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	UserID      types.Int64  `tfsdk:"user_id"`
+	Username    types.String `tfsdk:"username"`
+	ObjectType  types.String `tfsdk:"object_type"`
+	ObjectID    types.Int64  `tfsdk:"object_id"`
+	Description types.String `tfsdk:"description"`
+	Created     types.String `tfsdk:"created"`
+}
+
+// eventsFilterAttributes returns the attributes shared by the event data
+// sources: the filters and the list of matching events.
+func eventsFilterAttributes() superschema.Attributes {
+	return superschema.Attributes{
+		"object_type": superschema.StringAttribute{
+			DataSource: &schemaD.StringAttribute{
+				MarkdownDescription: "Only return events about objects of this type (e.g. `template`, `key`, `task`).",
+				Optional:            true,
+			},
+		},
+		"object_id": superschema.Int64Attribute{
+			DataSource: &schemaD.Int64Attribute{
+				MarkdownDescription: "Only return events about the object with this ID. Usually combined with `object_type`.",
+				Optional:            true,
+			},
+		},
+		"user_id": superschema.Int64Attribute{
+			DataSource: &schemaD.Int64Attribute{
+				MarkdownDescription: "Only return events caused by the user with this ID.",
+				Optional:            true,
+			},
+		},
+		"last": superschema.Int64Attribute{
+			DataSource: &schemaD.Int64Attribute{
+				MarkdownDescription: "Only return the given number of most recent matching events. The provider reads all events and counts the ones that match the filters, so unlike the `/events/last` API endpoint, which returns the last 200 events before any filter, it does not miss older matching events.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		"events": superschema.ListNestedAttribute{
+			DataSource: &schemaD.ListNestedAttribute{
+				MarkdownDescription: "The matching events, oldest first.",
+				Computed:            true,
+			},
+			Attributes: superschema.Attributes{
+				"project_id": superschema.Int64Attribute{
+					DataSource: &schemaD.Int64Attribute{
+						MarkdownDescription: "The ID of the project the event belongs to, `0` for global events.",
+						Computed:            true,
+					},
+				},
+				"user_id": superschema.Int64Attribute{
+					DataSource: &schemaD.Int64Attribute{
+						MarkdownDescription: "The ID of the user that caused the event, `0` for events caused by the system.",
+						Computed:            true,
+					},
+				},
+				"username": superschema.StringAttribute{
+					DataSource: &schemaD.StringAttribute{
+						MarkdownDescription: "The username of the user that caused the event.",
+						Computed:            true,
+					},
+				},
+				"object_type": superschema.StringAttribute{
+					DataSource: &schemaD.StringAttribute{
+						MarkdownDescription: "The type of the object the event is about.",
+						Computed:            true,
+					},
+				},
+				"object_id": superschema.Int64Attribute{
+					DataSource: &schemaD.Int64Attribute{
+						MarkdownDescription: "The ID of the object the event is about.",
+						Computed:            true,
+					},
+				},
+				"description": superschema.StringAttribute{
+					DataSource: &schemaD.StringAttribute{
+						MarkdownDescription: "The description of the event.",
+						Computed:            true,
+					},
+				},
+				"created": superschema.StringAttribute{
+					DataSource: &schemaD.StringAttribute{
+						MarkdownDescription: "The time the event occurred.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func EventsSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The events",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source allows you to read the event log of SemaphoreUI, including the events of all projects the user is a member of, e.g. to report who changed which object.",
+		},
+		Attributes: eventsFilterAttributes(),
+	}
+}
+
+func ProjectEventsSchema() superschema.Schema {
+	attributes := eventsFilterAttributes()
+	attributes["project_id"] = superschema.Int64Attribute{
+		DataSource: &schemaD.Int64Attribute{
+			MarkdownDescription: "The ID of the project to read the events of.",
+			Required:            true,
+		},
+	}
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project events",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source allows you to read the event log of a project, e.g. to report who changed which object.",
+		},
+		Attributes: attributes,
+	}
+}
@@ -29,15 +29,15 @@ func (d *externalUserDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -86,7 +86,7 @@ func convertExternalUserModelToUserRequest(user ExternalUserModel) *models.UserR
 func (r *externalUserDataSource) GetExternalUserByUsername(username string) (*ExternalUserModel, error) {
 	response, err := r.client.User.GetUsers(&user.GetUsersParams{}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not get users: %s", err.Error())
+		return nil, fmt.Errorf("could not get users: %w", err)
 	}
 	for _, usr := range response.Payload {
 		if usr.Username == username {
@@ -116,19 +116,13 @@ func (d *externalUserDataSource) Read(ctx context.Context, req datasource.ReadRe
 				User: convertExternalUserModelToUserRequest(config),
 			}, nil)
 			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Reading SemaphoreUI User",
-					"Could not create user, unexpected error: "+err.Error(),
-				)
+				resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI User", "Could not create user", err)...)
 				return
 			}
 			usr := convertResponseToExternalUserModel(response.Payload)
 			externalUser = &usr
 		} else {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI User",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI User", "", err)...)
 			return
 		}
 	}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -19,3 +21,25 @@ func testAccResourceNotExists(resourceName string) resource.TestCheckFunc {
 		return fmt.Errorf("resource %s still exists", resourceName)
 	}
 }
+
+// testAccDeleteOutOfBand deletes the object of a resource directly via the API
+// to simulate a deletion performed outside Terraform (e.g. from the web UI).
+// deleteObject receives the attributes of the resource in the state.
+func testAccDeleteOutOfBand(resourceName string, deleteObject func(attributes map[string]string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if err := deleteObject(rs.Primary.Attributes); err != nil {
+			return fmt.Errorf("error deleting %s out-of-band: %s", resourceName, err.Error())
+		}
+		return nil
+	}
+}
+
+// testAccInt64Attribute returns an integer attribute of a resource in the state.
+func testAccInt64Attribute(attributes map[string]string, name string) int64 {
+	value, _ := strconv.ParseInt(attributes[name], 10, 64)
+	return value
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// httpClientConfig holds the connection settings of the provider that apply
+// to the HTTP client used for the SemaphoreUI API.
+type httpClientConfig struct {
+	TLSSkipVerify  bool
+	CACertPEM      string
+	CACertFile     string
+	ClientCertPEM  string
+	ClientKeyPEM   string
+	HTTPProxy      string
+	RequestTimeout time.Duration
+	Headers        map[string]string
+	MaxRetries     int
+	RetryMaxWait   time.Duration
+	RetryUnsafe    bool
+}
+
+// headerTransport adds the configured headers, e.g. for an authenticating
+// reverse proxy in front of SemaphoreUI, and a request ID to every request.
+// The request ID is also set on the response, and added to errors, unless the
+// server returned its own.
+type headerTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	requestID := req.Header.Get(requestIDHeader)
+	if requestID == "" {
+		requestID = newRequestID()
+		req.Header.Set(requestIDHeader, requestID)
+	}
+
+	response, err := t.next.RoundTrip(req)
+	if err != nil {
+		return response, &requestIDError{err: err, requestID: requestID}
+	}
+	if response.Header == nil {
+		response.Header = http.Header{}
+	}
+	if response.Header.Get(requestIDHeader) == "" {
+		response.Header.Set(requestIDHeader, requestID)
+	}
+	return response, nil
+}
+
+// newTLSConfig returns the TLS settings for the configured CA bundle and
+// client certificate.
+func newTLSConfig(config httpClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.TLSSkipVerify}
+
+	caCertPEM := []byte(config.CACertPEM)
+	if config.CACertFile != "" {
+		data, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA certificate file: %w", err)
+		}
+		caCertPEM = append(caCertPEM, '\n')
+		caCertPEM = append(caCertPEM, data...)
+	}
+	if len(caCertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCertPEM) {
+			return nil, errors.New("no valid PEM encoded CA certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		if config.ClientCertPEM == "" || config.ClientKeyPEM == "" {
+			return nil, errors.New("both a client certificate and a client key must be set")
+		}
+		certificate, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// newHTTPClient returns the HTTP client for the SemaphoreUI API. Requests go
+// through the configured headers, then the retries and the timeout of every
+// attempt, then the logging of every attempt, then the connection settings. ctx is the context to log with.
+func newHTTPClient(ctx context.Context, config httpClientConfig) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if config.HTTPProxy != "" {
+		proxyURL, err := url.Parse(config.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &http.Client{
+		Transport: &headerTransport{
+			next:    newRetryTransport(newLoggingTransport(ctx, transport), config.MaxRetries, config.RetryMaxWait, config.RequestTimeout, config.RetryUnsafe),
+			headers: config.Headers,
+		},
+	}, nil
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewHTTPClient_caCert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(caCertPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]httpClientConfig{
+		"pem":  {CACertPEM: caCertPEM},
+		"file": {CACertFile: caCertFile},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := newHTTPClient(context.Background(), config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			response, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			_ = response.Body.Close()
+		})
+	}
+
+	client, err := newHTTPClient(context.Background(), httpClientConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if response, err := client.Get(server.URL); err == nil {
+		_ = response.Body.Close()
+		t.Error("expected an error for an untrusted certificate")
+	}
+}
+
+func TestNewHTTPClient_headers(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Proxy-Token")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := newHTTPClient(context.Background(), httpClientConfig{Headers: map[string]string{"X-Proxy-Token": "secret"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_ = response.Body.Close()
+
+	if got != "secret" {
+		t.Errorf("expected header %q, got %q", "secret", got)
+	}
+}
+
+func TestNewHTTPClient_invalid(t *testing.T) {
+	tests := map[string]httpClientConfig{
+		"ca pem":          {CACertPEM: "not a certificate"},
+		"ca file":         {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"client cert":     {ClientCertPEM: "not a certificate", ClientKeyPEM: "not a key"},
+		"client key only": {ClientKeyPEM: "not a key"},
+		"proxy":           {HTTPProxy: "http://proxy example.com"},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPClient(context.Background(), config); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
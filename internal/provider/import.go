@@ -4,29 +4,335 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+	"terraform-provider-semaphoreui/semaphoreui/client/inventory"
+	"terraform-provider-semaphoreui/semaphoreui/client/key_store"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/repository"
+	"terraform-provider-semaphoreui/semaphoreui/client/runner"
+	"terraform-provider-semaphoreui/semaphoreui/client/template"
+	"terraform-provider-semaphoreui/semaphoreui/client/user"
+	"terraform-provider-semaphoreui/semaphoreui/client/variable_group"
+	"terraform-provider-semaphoreui/semaphoreui/models"
 )
 
-func parseImportFields(input string, requiredFields []string) (map[string]int64, error) {
-	result := make(map[string]int64)
-	re := regexp.MustCompile(`(\w+)/(\d+)/?`)
-	matches := re.FindAllStringSubmatch(input, -1)
+var (
+	importFieldRegex = regexp.MustCompile(`^\w+$`)
+	importIDRegex    = regexp.MustCompile(`^\d+$`)
+)
 
-	for _, match := range matches {
-		if len(match) != 3 {
-			continue
+// importCandidate is an object an import name may refer to.
+type importCandidate struct {
+	id   int64
+	name string
+}
+
+// importNameLookup lists the objects whose names an import field may hold.
+// fields holds the IDs of the fields before it in the import ID, e.g. the
+// project of a template.
+type importNameLookup func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error)
+
+// importNameLookups are the import fields that can be given by name, with
+// the list endpoints that resolve them. Schedules, tasks and integration
+// aliases have no name and can only be imported by ID.
+var importNameLookups = map[string]importNameLookup{
+	"project": func(client *apiclient.SemaphoreUI, _ map[string]int64) ([]importCandidate, error) {
+		response, err := client.Project.GetProjects(&project.GetProjectsParams{}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"template": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Template.GetProjectProjectIDTemplates(&template.GetProjectProjectIDTemplatesParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"key": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.KeyStore.GetProjectProjectIDKeys(&key_store.GetProjectProjectIDKeysParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"inventory": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Inventory.GetProjectProjectIDInventory(&inventory.GetProjectProjectIDInventoryParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"environment": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.VariableGroup.GetProjectProjectIDEnvironment(&variable_group.GetProjectProjectIDEnvironmentParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"repository": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Repository.GetProjectProjectIDRepositories(&repository.GetProjectProjectIDRepositoriesParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"view": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Project.GetProjectProjectIDViews(&project.GetProjectProjectIDViewsParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Title})
+		}
+		return candidates, nil
+	},
+	"integration": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Integration.GetProjectProjectIDIntegrations(&integration.GetProjectProjectIDIntegrationsParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"matcher": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Integration.GetProjectProjectIDIntegrationsIntegrationIDMatchers(&integration.GetProjectProjectIDIntegrationsIntegrationIDMatchersParams{
+			ProjectID:     fields["project"],
+			IntegrationID: fields["integration"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
 		}
-		value, err := strconv.ParseInt(match[2], 10, 64)
+		return candidates, nil
+	},
+	"value": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Integration.GetProjectProjectIDIntegrationsIntegrationIDValues(&integration.GetProjectProjectIDIntegrationsIntegrationIDValuesParams{
+			ProjectID:     fields["project"],
+			IntegrationID: fields["integration"],
+		}, nil)
 		if err != nil {
 			return nil, err
 		}
-		result[match[1]] = value
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	"runner": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		var runners []*models.Runner
+		if projectID, ok := fields["project"]; ok {
+			response, err := client.Runner.GetProjectProjectIDRunners(&runner.GetProjectProjectIDRunnersParams{
+				ProjectID: projectID,
+			}, nil)
+			if err != nil {
+				return nil, err
+			}
+			runners = response.Payload
+		} else {
+			response, err := client.Runner.GetRunners(&runner.GetRunnersParams{}, nil)
+			if err != nil {
+				return nil, err
+			}
+			runners = response.Payload
+		}
+		var candidates []importCandidate
+		for _, item := range runners {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		}
+		return candidates, nil
+	},
+	// Users are imported by their username, which is unique.
+	"user": func(client *apiclient.SemaphoreUI, _ map[string]int64) ([]importCandidate, error) {
+		response, err := client.User.GetUsers(&user.GetUsersParams{}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Username})
+		}
+		return candidates, nil
+	},
+	// Invites are imported by the email they were sent to.
+	"invite": func(client *apiclient.SemaphoreUI, fields map[string]int64) ([]importCandidate, error) {
+		response, err := client.Project.GetProjectProjectIDInvites(&project.GetProjectProjectIDInvitesParams{
+			ProjectID: fields["project"],
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, item := range response.Payload {
+			candidates = append(candidates, importCandidate{id: item.ID, name: item.Email.String()})
+		}
+		return candidates, nil
+	},
+}
+
+// splitImportID splits an import ID into its fields and values, e.g.
+// `project/Infra/template/Deploy web` into project=Infra and
+// template=Deploy web. Slashes in values are escaped as `\/`, and backslashes
+// as `\\`.
+func splitImportID(input string) ([][2]string, error) {
+	var segments []string
+	var segment strings.Builder
+	for i := 0; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			if i+1 == len(input) || (input[i+1] != '/' && input[i+1] != '\\') {
+				return nil, fmt.Errorf("invalid escape at position %d, only `\\/` and `\\\\` are supported", i+1)
+			}
+			i++
+			segment.WriteByte(input[i])
+		case '/':
+			segments = append(segments, segment.String())
+			segment.Reset()
+		default:
+			segment.WriteByte(input[i])
+		}
+	}
+	// Allow a trailing slash.
+	if segment.Len() > 0 || len(segments)%2 != 0 {
+		segments = append(segments, segment.String())
+	}
+
+	if len(segments)%2 != 0 {
+		return nil, fmt.Errorf("missing value of import field %s", segments[len(segments)-1])
+	}
+	pairs := make([][2]string, 0, len(segments)/2)
+	for i := 0; i < len(segments); i += 2 {
+		if !importFieldRegex.MatchString(segments[i]) {
+			return nil, fmt.Errorf("invalid import field %q", segments[i])
+		}
+		if segments[i+1] == "" {
+			return nil, fmt.Errorf("missing value of import field %s", segments[i])
+		}
+		pairs = append(pairs, [2]string{segments[i], segments[i+1]})
+	}
+	return pairs, nil
+}
+
+// resolveImportName returns the ID of the only candidate with the name.
+func resolveImportName(field, name string, candidates []importCandidate) (int64, error) {
+	var ids []string
+	var id int64
+	for _, candidate := range candidates {
+		if candidate.name == name {
+			id = candidate.id
+			ids = append(ids, strconv.FormatInt(candidate.id, 10))
+		}
 	}
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no %s named %q was found", field, name)
+	case 1:
+		return id, nil
+	default:
+		return 0, fmt.Errorf("%d objects of type %s are named %q (IDs %s), import by ID instead", len(ids), field, name, strings.Join(ids, ", "))
+	}
+}
 
+// parseImportFields parses an import ID such as `project/1/template/2` into
+// the IDs of its fields. Values that are not numbers are names, such as in
+// `project/Infra/template/Deploy web`, and are resolved to IDs through the
+// list endpoints of the API, in the order of the import ID.
+func parseImportFields(client *apiclient.SemaphoreUI, input string, requiredFields []string) (map[string]int64, error) {
+	pairs, err := splitImportID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the required fields first, as resolving names depends on them.
+	given := make(map[string]bool)
+	for _, pair := range pairs {
+		given[pair[0]] = true
+	}
 	for _, field := range requiredFields {
-		if _, ok := result[field]; !ok {
+		if !given[field] {
 			return nil, fmt.Errorf("missing required import field %s", field)
 		}
 	}
 
+	result := make(map[string]int64)
+	for _, pair := range pairs {
+		field, value := pair[0], pair[1]
+		if importIDRegex.MatchString(value) {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			result[field] = id
+			continue
+		}
+
+		lookup, ok := importNameLookups[field]
+		if !ok {
+			return nil, fmt.Errorf("import field %s must be a numeric ID, got %q", field, value)
+		}
+		if client == nil {
+			return nil, fmt.Errorf("could not resolve the %s named %q, the provider is not configured", field, value)
+		}
+		candidates, err := lookup(client, result)
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve the %s named %q: %w", field, value, err)
+		}
+		id, err := resolveImportName(field, value, candidates)
+		if err != nil {
+			return nil, err
+		}
+		result[field] = id
+	}
+
 	return result, nil
 }
@@ -0,0 +1,654 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+	"terraform-provider-semaphoreui/semaphoreui/client/inventory"
+	"terraform-provider-semaphoreui/semaphoreui/client/key_store"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/repository"
+	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
+	"terraform-provider-semaphoreui/semaphoreui/client/template"
+	"terraform-provider-semaphoreui/semaphoreui/client/user"
+	"terraform-provider-semaphoreui/semaphoreui/client/variable_group"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// importLabelRegex matches the characters that are not allowed in the labels
+// of generated resources.
+var importLabelRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// importReferenceAttributes maps the attributes that hold the ID of another
+// object to the kind of the object, so that the generated configuration
+// references the generated resource instead of the ID.
+var importReferenceAttributes = map[string]string{
+	"become_key_id":     "key",
+	"build_template_id": "template",
+	"environment_id":    "environment",
+	"integration_id":    "integration",
+	"inventory_id":      "inventory",
+	"key_id":            "key",
+	"project_id":        "project",
+	"repository_id":     "repository",
+	"ssh_key_id":        "key",
+	"template_id":       "template",
+	"user_id":           "user",
+	"vault_key_id":      "key",
+	"view_id":           "view",
+}
+
+// importObject is an object of the SemaphoreUI instance to generate the
+// configuration of.
+type importObject struct {
+	newResource func() resource.Resource
+	address     string
+	importID    string
+}
+
+// importGenerator generates the configuration and import blocks of the objects
+// of a SemaphoreUI instance.
+type importGenerator struct {
+	data      *providerData
+	client    *apiclient.SemaphoreUI
+	objects   []importObject
+	labels    map[string]bool
+	refs      map[string]map[int64]string
+	variables *hclwrite.Body
+	resources *hclwrite.Body
+}
+
+func newImportGenerator(data *providerData, variables, resources *hclwrite.Body) *importGenerator {
+	return &importGenerator{
+		data:      data,
+		client:    data.client,
+		labels:    make(map[string]bool),
+		refs:      make(map[string]map[int64]string),
+		variables: variables,
+		resources: resources,
+	}
+}
+
+// GenerateImports writes the configuration of the projects, keys,
+// repositories, inventories, environments, views, templates, schedules,
+// integrations and users of the SemaphoreUI instance the provider is
+// configured for by its environment variables, with the import blocks that
+// adopt them. Secrets are replaced with sensitive variables. projects limits
+// the projects to the given IDs or names, all projects are generated when it
+// is empty.
+func GenerateImports(ctx context.Context, version string, projects []string, w io.Writer) error {
+	data, err := newImportProviderData(ctx, version)
+	if err != nil {
+		return err
+	}
+	// End the session of a username and password login with the run.
+	defer func() { _ = Close(ctx) }()
+
+	variables := hclwrite.NewEmptyFile()
+	resources := hclwrite.NewEmptyFile()
+	g := newImportGenerator(data, variables.Body(), resources.Body())
+	if err := g.collect(projects); err != nil {
+		return err
+	}
+	for _, object := range g.objects {
+		if err := g.generate(ctx, object); err != nil {
+			return err
+		}
+	}
+
+	if _, err := w.Write(hclwrite.Format(variables.Bytes())); err != nil {
+		return err
+	}
+	_, err = w.Write(hclwrite.Format(resources.Bytes()))
+	return err
+}
+
+// newImportProviderData configures the provider from its environment
+// variables and returns the data it passes to resources.
+func newImportProviderData(ctx context.Context, version string) (*providerData, error) {
+	p := New(version)()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(schemaResp.Diagnostics)
+	}
+
+	// An empty configuration, so that every setting comes from the
+	// environment variables.
+	attributes := make(map[string]tftypes.Value)
+	for name, attribute := range schemaResp.Schema.Attributes {
+		attributes[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+	}
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), attributes),
+	}
+
+	var configureResp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(configureResp.Diagnostics)
+	}
+	data, ok := configureResp.ResourceData.(*providerData)
+	if !ok {
+		return nil, errors.New("the provider did not create an API client")
+	}
+	return data, nil
+}
+
+// diagnosticsError returns the errors of diagnostics as an error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+	return errors.New(strings.Join(messages, "\n"))
+}
+
+// importLabel returns a label for a resource from the names of the object,
+// e.g. infra_deploy_web for the template "Deploy web" of the project "Infra".
+func importLabel(names ...string) string {
+	var parts []string
+	for _, name := range names {
+		part := strings.Trim(importLabelRegex.ReplaceAllString(strings.ToLower(name), "_"), "_")
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	label := strings.Join(parts, "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "_" + label
+	}
+	return label
+}
+
+// add registers an object, with a label that is unique for its resource type.
+func (g *importGenerator) add(kind string, id int64, resourceType string, newResource func() resource.Resource, label string, importID string) {
+	address := resourceType + "." + label
+	for i := 2; g.labels[address]; i++ {
+		address = fmt.Sprintf("%s.%s_%d", resourceType, label, i)
+	}
+	g.labels[address] = true
+
+	if g.refs[kind] == nil {
+		g.refs[kind] = make(map[int64]string)
+	}
+	g.refs[kind][id] = address
+
+	g.objects = append(g.objects, importObject{
+		newResource: newResource,
+		address:     address,
+		importID:    importID,
+	})
+}
+
+// collect lists the objects of the SemaphoreUI instance. All objects are
+// listed before any configuration is generated, so that resources can
+// reference the resources generated after them.
+func (g *importGenerator) collect(projects []string) error {
+	users, err := g.client.User.GetUsers(&user.GetUsersParams{}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list users: %w", err)
+	}
+	for _, item := range users.Payload {
+		g.add("user", item.ID, "semaphoreui_user", NewUserResource, importLabel(item.Username), fmt.Sprintf("user/%d", item.ID))
+	}
+
+	response, err := g.client.Project.GetProjects(&project.GetProjectsParams{}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list projects: %w", err)
+	}
+	selected, err := selectImportProjects(response.Payload, projects)
+	if err != nil {
+		return err
+	}
+	for _, item := range selected {
+		if err := g.collectProject(item); err != nil {
+			return fmt.Errorf("could not list the objects of project %q: %w", item.Name, err)
+		}
+	}
+	return nil
+}
+
+// selectImportProjects returns the projects with the given IDs or names, or
+// all projects when none are given.
+func selectImportProjects(projects []*models.Project, selection []string) ([]*models.Project, error) {
+	if len(selection) == 0 {
+		return projects, nil
+	}
+
+	var candidates []importCandidate
+	byID := make(map[int64]*models.Project)
+	for _, item := range projects {
+		candidates = append(candidates, importCandidate{id: item.ID, name: item.Name})
+		byID[item.ID] = item
+	}
+
+	var selected []*models.Project
+	for _, value := range selection {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			id, err = resolveImportName("project", value, candidates)
+			if err != nil {
+				return nil, err
+			}
+		}
+		item, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("no project with ID %d was found", id)
+		}
+		selected = append(selected, item)
+	}
+	return selected, nil
+}
+
+func (g *importGenerator) collectProject(item *models.Project) error {
+	projectID := item.ID
+	projectLabel := importLabel(item.Name)
+	importID := func(field string, id int64) string {
+		return fmt.Sprintf("project/%d/%s/%d", projectID, field, id)
+	}
+
+	g.add("project", projectID, "semaphoreui_project", NewProjectResource, projectLabel, fmt.Sprintf("project/%d", projectID))
+
+	users, err := g.client.Project.GetProjectProjectIDUsers(&project.GetProjectProjectIDUsersParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list users: %w", err)
+	}
+	for _, item := range users.Payload {
+		g.add("project_user", item.ID, "semaphoreui_project_user", NewProjectUserResource, importLabel(projectLabel, item.Username), importID("user", item.ID))
+	}
+
+	keys, err := g.client.KeyStore.GetProjectProjectIDKeys(&key_store.GetProjectProjectIDKeysParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list keys: %w", err)
+	}
+	for _, item := range keys.Payload {
+		g.add("key", item.ID, "semaphoreui_project_key", NewProjectKeyResource, importLabel(projectLabel, item.Name), importID("key", item.ID))
+	}
+
+	repositories, err := g.client.Repository.GetProjectProjectIDRepositories(&repository.GetProjectProjectIDRepositoriesParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list repositories: %w", err)
+	}
+	for _, item := range repositories.Payload {
+		g.add("repository", item.ID, "semaphoreui_project_repository", NewProjectRepositoryResource, importLabel(projectLabel, item.Name), importID("repository", item.ID))
+	}
+
+	inventories, err := g.client.Inventory.GetProjectProjectIDInventory(&inventory.GetProjectProjectIDInventoryParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list inventories: %w", err)
+	}
+	for _, item := range inventories.Payload {
+		g.add("inventory", item.ID, "semaphoreui_project_inventory", NewProjectInventoryResource, importLabel(projectLabel, item.Name), importID("inventory", item.ID))
+	}
+
+	environments, err := g.client.VariableGroup.GetProjectProjectIDEnvironment(&variable_group.GetProjectProjectIDEnvironmentParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list environments: %w", err)
+	}
+	for _, item := range environments.Payload {
+		g.add("environment", item.ID, "semaphoreui_project_environment", NewProjectEnvironmentResource, importLabel(projectLabel, item.Name), importID("environment", item.ID))
+	}
+
+	views, err := g.client.Project.GetProjectProjectIDViews(&project.GetProjectProjectIDViewsParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list views: %w", err)
+	}
+	for _, item := range views.Payload {
+		g.add("view", item.ID, "semaphoreui_project_view", NewProjectViewResource, importLabel(projectLabel, item.Title), importID("view", item.ID))
+	}
+
+	templates, err := g.client.Template.GetProjectProjectIDTemplates(&template.GetProjectProjectIDTemplatesParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list templates: %w", err)
+	}
+	for _, item := range templates.Payload {
+		g.add("template", item.ID, "semaphoreui_project_template", NewProjectTemplateResource, importLabel(projectLabel, item.Name), importID("template", item.ID))
+	}
+	for _, item := range templates.Payload {
+		schedules, err := g.client.Schedule.GetProjectProjectIDTemplatesTemplateIDSchedules(&schedule.GetProjectProjectIDTemplatesTemplateIDSchedulesParams{
+			ProjectID:  projectID,
+			TemplateID: item.ID,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not list the schedules of template %q: %w", item.Name, err)
+		}
+		for _, scheduleItem := range schedules.Payload {
+			g.add("schedule", scheduleItem.ID, "semaphoreui_project_schedule", NewProjectScheduleResource, importLabel(projectLabel, scheduleItem.Name), importID("schedule", scheduleItem.ID))
+		}
+	}
+
+	integrations, err := g.client.Integration.GetProjectProjectIDIntegrations(&integration.GetProjectProjectIDIntegrationsParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("could not list integrations: %w", err)
+	}
+	for _, item := range integrations.Payload {
+		g.add("integration", item.ID, "semaphoreui_project_integration", NewProjectIntegrationResource, importLabel(projectLabel, item.Name), importID("integration", item.ID))
+	}
+
+	return nil
+}
+
+// generate imports an object the way `terraform import` does, with the
+// ImportState and Read of its resource, and writes its configuration.
+func (g *importGenerator) generate(ctx context.Context, object importObject) error {
+	r := object.newResource()
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: g.data}, &resp)
+		if resp.Diagnostics.HasError() {
+			return diagnosticsError(resp.Diagnostics)
+		}
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return diagnosticsError(schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: object.importID}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		return fmt.Errorf("could not import %s: %w", object.address, diagnosticsError(importResp.Diagnostics))
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		return fmt.Errorf("could not read %s: %w", object.address, diagnosticsError(readResp.Diagnostics))
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := readResp.State.Raw.As(&attributes); err != nil {
+		return fmt.Errorf("could not read %s: %w", object.address, err)
+	}
+	return g.writeResource(object.address, object.importID, s.Attributes, attributes)
+}
+
+// writeResource writes the resource block and the import block of an object.
+func (g *importGenerator) writeResource(address, importID string, attributes map[string]schema.Attribute, values map[string]tftypes.Value) error {
+	resourceType, label, _ := strings.Cut(address, ".")
+
+	block := g.resources.AppendNewBlock("resource", []string{resourceType, label})
+	for _, name := range importAttributeNames(attributes) {
+		tokens, err := g.attributeTokens(address, []string{name}, attributes[name], values[name])
+		if err != nil {
+			return fmt.Errorf("could not generate %s.%s: %w", address, name, err)
+		}
+		if tokens != nil {
+			block.Body().SetAttributeRaw(name, tokens)
+		}
+	}
+	g.resources.AppendNewline()
+
+	importBlock := g.resources.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(importID))
+	g.resources.AppendNewline()
+	return nil
+}
+
+// importAttributeNames returns the names of the attributes in the order they
+// are generated: project_id first, then by name.
+func importAttributeNames(attributes map[string]schema.Attribute) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == "project_id") != (names[j] == "project_id") {
+			return names[i] == "project_id"
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// nestedAttributes returns the attributes of the objects of a nested
+// attribute.
+func nestedAttributes(attribute schema.Attribute) (map[string]schema.Attribute, bool) {
+	switch attribute := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return attribute.Attributes, true
+	case schema.ListNestedAttribute:
+		return attribute.NestedObject.Attributes, true
+	case schema.SetNestedAttribute:
+		return attribute.NestedObject.Attributes, true
+	case schema.MapNestedAttribute:
+		return attribute.NestedObject.Attributes, true
+	}
+	return nil, false
+}
+
+// attributeTokens returns the value of an attribute in the configuration, or
+// nil when the attribute is not configured. Sensitive attributes become
+// variables, and the IDs of generated objects become references.
+func (g *importGenerator) attributeTokens(address string, path []string, attribute schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	if attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() {
+		return nil, nil
+	}
+	if attribute.IsWriteOnly() {
+		return nil, nil
+	}
+
+	if attribute.IsSensitive() && (attribute.IsRequired() || !value.IsNull()) {
+		_, label, _ := strings.Cut(address, ".")
+		name := label + "_" + strings.Join(path, "_")
+		for i := 2; g.labels["var."+name]; i++ {
+			name = fmt.Sprintf("%s_%s_%d", label, strings.Join(path, "_"), i)
+		}
+		g.labels["var."+name] = true
+		variable := g.variables.AppendNewBlock("variable", []string{name})
+		variable.Body().SetAttributeValue("description", cty.StringVal(fmt.Sprintf("The %s of %s.", strings.Join(path, "."), address)))
+		variable.Body().SetAttributeValue("sensitive", cty.True)
+		g.variables.AppendNewline()
+		return hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: "var"},
+			hcl.TraverseAttr{Name: name},
+		}), nil
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return nil, nil
+	}
+
+	if kind, ok := importReferenceAttributes[path[len(path)-1]]; ok && value.Type().Is(tftypes.Number) {
+		var number big.Float
+		if err := value.As(&number); err != nil {
+			return nil, err
+		}
+		id, _ := number.Int64()
+		if address, ok := g.refs[kind][id]; ok {
+			resourceType, resourceLabel, _ := strings.Cut(address, ".")
+			return hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: resourceType},
+				hcl.TraverseAttr{Name: resourceLabel},
+				hcl.TraverseAttr{Name: "id"},
+			}), nil
+		}
+	}
+
+	attributes, nested := nestedAttributes(attribute)
+	if !nested {
+		converted, err := importCtyValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(converted), nil
+	}
+
+	switch attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return g.objectTokens(address, path, attributes, value)
+	case schema.MapNestedAttribute:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(elements))
+		for key := range elements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var items []hclwrite.ObjectAttrTokens
+		for _, key := range keys {
+			tokens, err := g.objectTokens(address, importPath(path, importLabel(key)), attributes, elements[key])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: tokens,
+			})
+		}
+		return hclwrite.TokensForObject(items), nil
+	default:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var items []hclwrite.Tokens
+		for i, element := range elements {
+			tokens, err := g.objectTokens(address, importPath(path, importElementName(i, element)), attributes, element)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, tokens)
+		}
+		return hclwrite.TokensForTuple(items), nil
+	}
+}
+
+// importPath returns the path of a nested attribute or element.
+func importPath(path []string, name string) []string {
+	return append(append([]string(nil), path...), name)
+}
+
+// importElementName names an element of a list of objects in the names of
+// variables: by its name attribute when it has one, e.g. the name of an
+// environment secret, or else by its index.
+func importElementName(index int, element tftypes.Value) string {
+	var attributes map[string]tftypes.Value
+	if err := element.As(&attributes); err == nil {
+		if name, ok := attributes["name"]; ok && name.Type().Is(tftypes.String) && !name.IsNull() {
+			var value string
+			if err := name.As(&value); err == nil && value != "" {
+				return importLabel(value)
+			}
+		}
+	}
+	return strconv.Itoa(index)
+}
+
+// objectTokens returns the value of a nested object in the configuration.
+func (g *importGenerator) objectTokens(address string, path []string, attributes map[string]schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return nil, err
+	}
+
+	var items []hclwrite.ObjectAttrTokens
+	for _, name := range importAttributeNames(attributes) {
+		tokens, err := g.attributeTokens(address, importPath(path, name), attributes[name], values[name])
+		if err != nil {
+			return nil, err
+		}
+		if tokens != nil {
+			items = append(items, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier(name),
+				Value: tokens,
+			})
+		}
+	}
+	return hclwrite.TokensForObject(items), nil
+}
+
+// importCtyValue converts a value of the state to a value of the
+// configuration.
+func importCtyValue(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return cty.StringVal(s), err
+	case typ.Is(tftypes.Number):
+		var number big.Float
+		err := value.As(&number)
+		return cty.NumberVal(&number), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return cty.BoolVal(b), err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elements) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		converted := make([]cty.Value, len(elements))
+		for i, element := range elements {
+			v, err := importCtyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[i] = v
+		}
+		return cty.TupleVal(converted), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elements) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		converted := make(map[string]cty.Value, len(elements))
+		for key, element := range elements {
+			v, err := importCtyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[key] = v
+		}
+		return cty.ObjectVal(converted), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported value type %s", typ)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportLabel(t *testing.T) {
+	tests := []struct {
+		names    []string
+		expected string
+	}{
+		{[]string{"Infra", "Deploy web"}, "infra_deploy_web"},
+		{[]string{"Infra", "  SSH key (prod) "}, "infra_ssh_key_prod"},
+		{[]string{"2024 releases"}, "_2024_releases"},
+		{[]string{"!!!"}, "_"},
+	}
+	for _, test := range tests {
+		if got := importLabel(test.names...); got != test.expected {
+			t.Errorf("importLabel(%q) = %q, expected %q", test.names, got, test.expected)
+		}
+	}
+}
+
+func TestImportGenerator_writeResource(t *testing.T) {
+	variables := hclwrite.NewEmptyFile()
+	resources := hclwrite.NewEmptyFile()
+	g := newImportGenerator(&providerData{}, variables.Body(), resources.Body())
+	g.add("project", 1, "semaphoreui_project", nil, "infra", "project/1")
+
+	attributes := map[string]schema.Attribute{
+		"id":         schema.Int64Attribute{Computed: true},
+		"project_id": schema.Int64Attribute{Required: true},
+		"name":       schema.StringAttribute{Required: true},
+		"tags":       schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		"ssh": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"login":       schema.StringAttribute{Optional: true},
+				"private_key": schema.StringAttribute{Required: true, Sensitive: true},
+			},
+		},
+	}
+	sshType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"login":       tftypes.String,
+		"private_key": tftypes.String,
+	}}
+	values := map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.Number, 5),
+		"project_id": tftypes.NewValue(tftypes.Number, 1),
+		"name":       tftypes.NewValue(tftypes.String, "Deploy key"),
+		"tags":       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"ssh": tftypes.NewValue(sshType, map[string]tftypes.Value{
+			"login":       tftypes.NewValue(tftypes.String, "root"),
+			"private_key": tftypes.NewValue(tftypes.String, ""),
+		}),
+	}
+
+	if err := g.writeResource("semaphoreui_project_key.infra_deploy_key", "project/1/key/5", attributes, values); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := string(hclwrite.Format(resources.Bytes()))
+	for _, expected := range []string{
+		`resource "semaphoreui_project_key" "infra_deploy_key" {`,
+		`project_id = semaphoreui_project.infra.id`,
+		`name       = "Deploy key"`,
+		`login       = "root"`,
+		`private_key = var.infra_deploy_key_ssh_private_key`,
+		`to = semaphoreui_project_key.infra_deploy_key`,
+		`id = "project/1/key/5"`,
+	} {
+		if !strings.Contains(got, expected) {
+			t.Errorf("expected the configuration to contain %q, got:\n%s", expected, got)
+		}
+	}
+	for _, unexpected := range []string{"tags", "= 5\n"} {
+		if strings.Contains(got, unexpected) {
+			t.Errorf("expected the configuration not to contain %q, got:\n%s", unexpected, got)
+		}
+	}
+
+	if got := string(variables.Bytes()); !strings.Contains(got, `variable "infra_deploy_key_ssh_private_key"`) || !strings.Contains(got, "sensitive") {
+		t.Errorf("expected a sensitive variable for the private key, got:\n%s", got)
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitImportID(t *testing.T) {
+	tests := map[string][][2]string{
+		"project/1/template/2":              {{"project", "1"}, {"template", "2"}},
+		"project/1/template/2/":             {{"project", "1"}, {"template", "2"}},
+		"project/Infra/template/Deploy web": {{"project", "Infra"}, {"template", "Deploy web"}},
+		`project/Infra\/Prod/key/a\\b`:      {{"project", "Infra/Prod"}, {"key", `a\b`}},
+	}
+	for input, expected := range tests {
+		got, err := splitImportID(input)
+		if err != nil {
+			t.Errorf("splitImportID(%q) returned an unexpected error: %s", input, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("splitImportID(%q) = %v, expected %v", input, got, expected)
+		}
+	}
+
+	for _, input := range []string{"project", "project/", "project//template/2", "project/1/bad field/2", `project/a\b`, `project/a\`} {
+		if _, err := splitImportID(input); err == nil {
+			t.Errorf("splitImportID(%q) expected an error", input)
+		}
+	}
+}
+
+func TestResolveImportName(t *testing.T) {
+	candidates := []importCandidate{
+		{id: 1, name: "Deploy"},
+		{id: 2, name: "Build"},
+		{id: 3, name: "Build"},
+	}
+
+	id, err := resolveImportName("template", "Deploy", candidates)
+	if err != nil || id != 1 {
+		t.Errorf("expected ID 1, got %d (%v)", id, err)
+	}
+
+	_, err = resolveImportName("template", "Build", candidates)
+	if err == nil || !strings.Contains(err.Error(), "IDs 2, 3") {
+		t.Errorf("expected an ambiguous name error, got %v", err)
+	}
+
+	_, err = resolveImportName("template", "deploy", candidates)
+	if err == nil || !strings.Contains(err.Error(), `no template named "deploy"`) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestParseImportFields(t *testing.T) {
+	fields, err := parseImportFields(nil, "project/1/template/2", []string{"project", "template"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := map[string]int64{"project": 1, "template": 2}; !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected %v, got %v", expected, fields)
+	}
+
+	tests := []struct {
+		input          string
+		requiredFields []string
+		expected       string
+	}{
+		{"project/1", []string{"project", "template"}, "missing required import field template"},
+		{"template/Deploy", []string{"project", "template"}, "missing required import field project"},
+		{"project/1/schedule/Daily", []string{"project", "schedule"}, "import field schedule must be a numeric ID"},
+	}
+	for _, test := range tests {
+		_, err := parseImportFields(nil, test.input, test.requiredFields)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("parseImportFields(%q) expected error %q, got %v", test.input, test.expected, err)
+		}
+	}
+}
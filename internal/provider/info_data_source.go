@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &infoDataSource{}
+)
+
+func NewInfoDataSource() datasource.DataSource {
+	return &infoDataSource{}
+}
+
+type infoDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *infoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *infoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *infoDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = InfoSchema().GetDataSource(ctx)
+}
+
+func convertInfoResponseToInfoModel(ctx context.Context, response *models.InfoType) (InfoModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	authMethods := "{}"
+	if response.AuthMethods != nil {
+		data, err := json.Marshal(response.AuthMethods)
+		if err != nil {
+			diags.AddError(
+				"Error Encoding SemaphoreUI Server Information",
+				"Could not encode authentication methods as JSON, unexpected error: "+err.Error(),
+			)
+			return InfoModel{}, diags
+		}
+		authMethods = string(data)
+	}
+
+	// Features are reported as a JSON object of booleans; anything else is
+	// ignored.
+	features := map[string]bool{}
+	if values, ok := response.Features.(map[string]any); ok {
+		for name, value := range values {
+			if enabled, ok := value.(bool); ok {
+				features[name] = enabled
+			}
+		}
+	}
+	featuresValue, d := types.MapValueFrom(ctx, types.BoolType, features)
+	diags.Append(d...)
+
+	return InfoModel{
+		Version:          types.StringValue(response.Version),
+		Ansible:          types.StringValue(response.Ansible),
+		GitClient:        types.StringValue(response.GitClient),
+		ScheduleTimezone: types.StringValue(response.ScheduleTimezone),
+		UseRemoteRunner:  types.BoolValue(response.UseRemoteRunner),
+		WebHost:          types.StringValue(response.WebHost),
+		AuthMethods:      types.StringValue(authMethods),
+		Features:         featuresValue,
+	}, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *infoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info, err := readServerInfo(d.client)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Server Information", "Could not read server information", err)...)
+		return
+	}
+
+	model, diags := convertInfoResponseToInfoModel(ctx, info)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_InfoDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "semaphoreui_info" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.semaphoreui_info.test", "version"),
+					resource.TestCheckResourceAttrSet("data.semaphoreui_info.test", "use_remote_runner"),
+					resource.TestCheckResourceAttrSet("data.semaphoreui_info.test", "auth_methods"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type InfoModel struct {
+	Version          types.String `tfsdk:"version"`
+	Ansible          types.String `tfsdk:"ansible"`
+	GitClient        types.String `tfsdk:"git_client"`
+	ScheduleTimezone types.String `tfsdk:"schedule_timezone"`
+	UseRemoteRunner  types.Bool   `tfsdk:"use_remote_runner"`
+	WebHost          types.String `tfsdk:"web_host"`
+	AuthMethods      types.String `tfsdk:"auth_methods"`
+	Features         types.Map    `tfsdk:"features"`
+}
+
+func InfoSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The server info",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source allows you to read the version and settings of the SemaphoreUI server, e.g. to only create objects that the server supports.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"version": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The SemaphoreUI version (e.g. `v2.16.18`).",
+					Computed:            true,
+				},
+			},
+			"ansible": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The version of Ansible installed on the server.",
+					Computed:            true,
+				},
+			},
+			"git_client": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The Git client used by the server (`cmd_git` or `go_git`).",
+					Computed:            true,
+				},
+			},
+			"schedule_timezone": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The timezone that schedules are evaluated in.",
+					Computed:            true,
+				},
+			},
+			"use_remote_runner": superschema.BoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Whether tasks are run on remote runners.",
+					Computed:            true,
+				},
+			},
+			"web_host": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The public URL of the server, used in links and webhooks.",
+					Computed:            true,
+				},
+			},
+			"auth_methods": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The authentication methods enabled on the server, as JSON. Use `jsondecode()` to read it.",
+					Computed:            true,
+				},
+			},
+			"features": superschema.MapAttribute{
+				DataSource: &schemaD.MapAttribute{
+					MarkdownDescription: "The optional features of the server, and whether they are enabled.",
+					ElementType:         types.BoolType,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -30,15 +30,15 @@ func (r *integrationAliasResource) Configure(_ context.Context, req resource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *integrationAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,10 +64,7 @@ func (r *integrationAliasResource) Create(ctx context.Context, req resource.Crea
 				IntegrationID: plan.IntegrationID.ValueInt64(),
 			}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Creating SemaphoreUI Integration Alias",
-				"Could not create integration-scoped alias, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Integration Alias", "Could not create integration-scoped alias", err)...)
 			return
 		}
 		payload = response.Payload
@@ -77,10 +74,7 @@ func (r *integrationAliasResource) Create(ctx context.Context, req resource.Crea
 				ProjectID: plan.ProjectID.ValueInt64(),
 			}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Creating SemaphoreUI Integration Alias",
-				"Could not create project-scoped alias, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Integration Alias", "Could not create project-scoped alias", err)...)
 			return
 		}
 		payload = response.Payload
@@ -134,11 +128,8 @@ func (r *integrationAliasResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	alias, err := r.findAlias(state.ProjectID.ValueInt64(), state.IntegrationID.ValueInt64(), state.ID.ValueInt64())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Integration Alias",
-			"Could not read integration alias, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Integration Alias", "Could not read integration alias", err)...)
 		return
 	}
 	if alias == nil {
@@ -174,11 +165,8 @@ func (r *integrationAliasResource) Delete(ctx context.Context, req resource.Dele
 				IntegrationID: state.IntegrationID.ValueInt64(),
 				AliasID:       state.ID.ValueInt64(),
 			}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Removing SemaphoreUI Integration Alias",
-				"Could not remove integration-scoped alias, unexpected error: "+err.Error(),
-			)
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Integration Alias", "Could not remove integration-scoped alias", err)...)
 		}
 		return
 	}
@@ -188,11 +176,8 @@ func (r *integrationAliasResource) Delete(ctx context.Context, req resource.Dele
 			ProjectID: state.ProjectID.ValueInt64(),
 			AliasID:   state.ID.ValueInt64(),
 		}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Integration Alias",
-			"Could not remove project-scoped alias, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Integration Alias", "Could not remove project-scoped alias", err)...)
 	}
 }
 
@@ -201,11 +186,11 @@ func (r *integrationAliasResource) Delete(ctx context.Context, req resource.Dele
 //	project/{project_id}/alias/{alias_id}                                 -> project-scoped
 //	project/{project_id}/integration/{integration_id}/alias/{alias_id}    -> integration-scoped
 func (r *integrationAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "alias"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "alias"})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Integration Alias Import ID",
-			fmt.Sprintf("Could not parse import ID %q: %s. Expected `project/{id}/alias/{id}` or `project/{id}/integration/{id}/alias/{id}`.", req.ID, err.Error()),
+			fmt.Sprintf("Could not parse import ID %q: %s. Expected `project/{id}/alias/{id}` or `project/{id}/integration/{id}/alias/{id}`, where the project and the integration may also be given by name.", req.ID, err.Error()),
 		)
 		return
 	}
@@ -222,10 +207,7 @@ func (r *integrationAliasResource) ImportState(ctx context.Context, req resource
 
 	alias, err := r.findAlias(state.ProjectID.ValueInt64(), state.IntegrationID.ValueInt64(), state.ID.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Integration Alias",
-			"Could not read integration alias during import, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Integration Alias", "Could not read integration alias during import", err)...)
 		return
 	}
 	if alias == nil {
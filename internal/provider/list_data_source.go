@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"terraform-provider-semaphoreui/internal/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// readOnlyAttributes makes the attributes of a data source, including nested
+// ones, read-only and removes their validators, so that the schema of a
+// singular data source describes the objects listed by a plural one.
+func readOnlyAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			attribute = a
+		case schema.Int64Attribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			attribute = a
+		case schema.BoolAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			attribute = a
+		case schema.ListAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			attribute = a
+		case schema.MapAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			attribute = a
+		case schema.SetAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			attribute = a
+		case schema.SingleNestedAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			a.Attributes = readOnlyAttributes(a.Attributes)
+			attribute = a
+		case schema.ListNestedAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			a.NestedObject.Attributes = readOnlyAttributes(a.NestedObject.Attributes)
+			a.NestedObject.Validators = nil
+			attribute = a
+		case schema.SetNestedAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			a.NestedObject.Attributes = readOnlyAttributes(a.NestedObject.Attributes)
+			a.NestedObject.Validators = nil
+			attribute = a
+		}
+		result[name] = attribute
+	}
+	return result
+}
+
+// listProjectIDAttribute returns the project_id attribute of a plural data
+// source listing the given objects of a project.
+func listProjectIDAttribute(objects string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf("The ID of the project to list the %s of.", objects),
+		Required:            true,
+	}
+}
+
+// nameRegexAttribute returns the name_regex filter of a plural data source.
+func nameRegexAttribute(objects, name string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only list the %s whose %s matches this regular expression, e.g. `^prod-`. All %s are listed when unset.", objects, name, objects),
+		Optional:            true,
+		Validators:          []validator.String{stringvalidator.Regex()},
+	}
+}
+
+// nameMatcher returns a function reporting whether a name matches the
+// name_regex filter of a plural data source. Every name matches when the
+// filter is not set.
+func nameMatcher(nameRegex types.String) (func(string) bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return func(string) bool { return true }, diags
+	}
+	re, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+		return nil, diags
+	}
+	return re.MatchString, diags
+}
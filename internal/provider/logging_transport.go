@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// logSubsystem is the tflog subsystem of the API request logs.
+	logSubsystem = "semaphoreui"
+	// requestIDHeader is the header that carries the ID of each request, so
+	// that provider failures can be correlated with the server logs.
+	requestIDHeader = "X-Request-Id"
+	// redactedValue replaces secrets in the logs.
+	redactedValue = "***"
+)
+
+// redactedFields are the JSON fields of request and response bodies that hold
+// secrets: key secrets, environment secrets, passwords, runner private keys
+// and tokens.
+var redactedFields = map[string]bool{
+	"passphrase":         true,
+	"password":           true,
+	"private_key":        true,
+	"registration_token": true,
+	"secret":             true,
+	"token":              true,
+}
+
+// apiTokenPathRegex matches the API paths of API tokens, which are identified
+// by their value.
+var apiTokenPathRegex = regexp.MustCompile(`/user/tokens(/[^/]+)?/?$`)
+
+// newRequestID returns a random ID for a request.
+func newRequestID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// requestIDError adds the ID of the failed request to an error.
+type requestIDError struct {
+	err       error
+	requestID string
+}
+
+func (e *requestIDError) Error() string {
+	return fmt.Sprintf("%s (request ID: %s)", e.err.Error(), e.requestID)
+}
+
+func (e *requestIDError) Unwrap() error {
+	return e.err
+}
+
+// loggingTransport logs every request and response at debug level in the
+// semaphoreui subsystem, with secrets redacted from paths and bodies.
+type loggingTransport struct {
+	// ctx is the provider context, as requests of the generated client do
+	// not carry the logger of the calling resource.
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func newLoggingTransport(ctx context.Context, next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		ctx:  context.WithoutCancel(ctx),
+		next: next,
+	}
+}
+
+// redactPath removes secrets from an API path.
+func redactPath(path string) string {
+	if match := apiTokenPathRegex.FindStringSubmatchIndex(path); match != nil && match[2] >= 0 {
+		return path[:match[2]] + "/" + redactedValue + path[match[3]:]
+	}
+	return path
+}
+
+// redactJSON replaces the values of secret fields in a decoded JSON value.
+// The ID of API tokens is the token itself.
+func redactJSON(value any, apiToken bool) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if redactedFields[key] || (apiToken && key == "id") {
+				if field != nil && field != "" {
+					value[key] = redactedValue
+				}
+				continue
+			}
+			value[key] = redactJSON(field, apiToken)
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = redactJSON(item, apiToken)
+		}
+	}
+	return value
+}
+
+// redactBody returns a body for the logs: JSON bodies with secrets redacted
+// and plain text bodies as they are. Other bodies are only described.
+func redactBody(body []byte, contentType, path string) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/json", "":
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		var value any
+		if err := decoder.Decode(&value); err == nil {
+			redacted, err := json.Marshal(redactJSON(value, apiTokenPathRegex.MatchString(path)))
+			if err == nil {
+				return string(redacted)
+			}
+		}
+		if mediaType == "" {
+			break
+		}
+		return fmt.Sprintf("<%d bytes of invalid JSON>", len(body))
+	case "text/plain":
+		return string(body)
+	}
+	if contentType == "" {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+}
+
+// readRequestBody returns the body of a request without consuming it.
+func readRequestBody(req *http.Request) []byte {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	return data
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := redactPath(req.URL.Path)
+	fields := map[string]any{
+		"method":     req.Method,
+		"path":       path,
+		"request_id": req.Header.Get(requestIDHeader),
+	}
+	if body := redactBody(readRequestBody(req), req.Header.Get("Content-Type"), path); body != "" {
+		fields["request_body"] = body
+	}
+	tflog.SubsystemDebug(t.ctx, logSubsystem, "Sending SemaphoreUI API request", fields)
+
+	start := time.Now()
+	response, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	delete(fields, "request_body")
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(t.ctx, logSubsystem, "SemaphoreUI API request failed", fields)
+		return response, err
+	}
+
+	fields["status"] = response.StatusCode
+	if response.Body != nil {
+		data, err := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			fields["error"] = err.Error()
+			tflog.SubsystemDebug(t.ctx, logSubsystem, "SemaphoreUI API request failed", fields)
+			return nil, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(data))
+		if body := redactBody(data, response.Header.Get("Content-Type"), path); body != "" {
+			fields["response_body"] = body
+		}
+	}
+	tflog.SubsystemDebug(t.ctx, logSubsystem, "Received SemaphoreUI API response", fields)
+
+	return response, nil
+}
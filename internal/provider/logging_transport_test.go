@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactPath(t *testing.T) {
+	tests := map[string]string{
+		"/api/project/1/keys/2":  "/api/project/1/keys/2",
+		"/api/user/tokens":       "/api/user/tokens",
+		"/api/user/tokens/abc12": "/api/user/tokens/***",
+	}
+	for path, expected := range tests {
+		if got := redactPath(path); got != expected {
+			t.Errorf("redactPath(%q) = %q, expected %q", path, got, expected)
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		path        string
+		expected    string
+	}{
+		{
+			name:        "key secrets",
+			body:        `{"name":"key","ssh":{"login":"root","passphrase":"pass","private_key":"-----BEGIN"}}`,
+			contentType: "application/json",
+			path:        "/api/project/1/keys",
+			expected:    `{"name":"key","ssh":{"login":"root","passphrase":"***","private_key":"***"}}`,
+		},
+		{
+			name:        "environment secrets",
+			body:        `{"password":"","secrets":[{"name":"TOKEN","secret":"value","type":"env"}]}`,
+			contentType: "application/json; charset=utf-8",
+			path:        "/api/project/1/environment",
+			expected:    `{"password":"","secrets":[{"name":"TOKEN","secret":"***","type":"env"}]}`,
+		},
+		{
+			name:        "runner",
+			body:        `{"id":1,"private_key":"-----BEGIN","token":"abc"}`,
+			contentType: "application/json",
+			path:        "/api/runners",
+			expected:    `{"id":1,"private_key":"***","token":"***"}`,
+		},
+		{
+			name:        "api token",
+			body:        `[{"id":"abc","expired":false}]`,
+			contentType: "application/json",
+			path:        "/api/user/tokens",
+			expected:    `[{"expired":false,"id":"***"}]`,
+		},
+		{
+			name:        "plain text",
+			body:        "template playbook can not be empty",
+			contentType: "text/plain; charset=utf-8",
+			expected:    "template playbook can not be empty",
+		},
+		{
+			name:        "binary",
+			body:        "\x00\x01",
+			contentType: "application/octet-stream",
+			expected:    "<2 bytes of application/octet-stream>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := redactBody([]byte(test.body), test.contentType, test.path); got != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestHeaderTransport_requestID(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(requestIDHeader)
+		_, _ = io.WriteString(w, `{"ok":true}`)
+	}))
+	defer server.Close()
+
+	client, err := newHTTPClient(context.Background(), httpClientConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	body, _ := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+
+	if got == "" || response.Header.Get(requestIDHeader) != got {
+		t.Errorf("expected the request ID %q to be set on the response, got %q", got, response.Header.Get(requestIDHeader))
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("expected the response body to be preserved, got %s", body)
+	}
+
+	server.Close()
+	if _, err := client.Get(server.URL); err == nil || !strings.Contains(err.Error(), "request ID: ") {
+		t.Errorf("expected the request ID in the error, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectBackupDataSource{}
+)
+
+func NewProjectBackupDataSource() datasource.DataSource {
+	return &projectBackupDataSource{}
+}
+
+type projectBackupDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectBackupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectBackupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_backup"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectBackupDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ProjectBackupSchema().GetDataSource(ctx)
+}
+
+func convertProjectBackupResponseToProjectBackupModel(projectID int64, backup *models.ProjectBackup) (ProjectBackupModel, error) {
+	data, err := json.Marshal(backup)
+	if err != nil {
+		return ProjectBackupModel{}, err
+	}
+
+	model := ProjectBackupModel{
+		ProjectID: types.Int64Value(projectID),
+		JSON:      types.StringValue(string(data)),
+	}
+
+	if backup.Meta != nil {
+		maxParallelTasks := types.Int64Value(0)
+		if backup.Meta.MaxParallelTasks != nil {
+			maxParallelTasks = types.Int64PointerValue(backup.Meta.MaxParallelTasks)
+		}
+		model.Meta = &ProjectBackupMetaModel{
+			Name:             types.StringValue(backup.Meta.Name),
+			Type:             types.StringValue(backup.Meta.Type),
+			Alert:            types.BoolValue(backup.Meta.Alert),
+			AlertChat:        types.StringValue(backup.Meta.AlertChat),
+			MaxParallelTasks: maxParallelTasks,
+		}
+	}
+
+	for _, key := range backup.Keys {
+		model.Keys = append(model.Keys, ProjectBackupKeyModel{
+			Name: types.StringValue(key.Name),
+			Type: types.StringValue(key.Type),
+		})
+	}
+
+	for _, repository := range backup.Repositories {
+		model.Repositories = append(model.Repositories, ProjectBackupRepositoryModel{
+			Name:      types.StringValue(repository.Name),
+			GitURL:    types.StringValue(repository.GitURL),
+			GitBranch: types.StringValue(repository.GitBranch),
+			SSHKey:    types.StringValue(repository.SSHKey),
+		})
+	}
+
+	for _, inventory := range backup.Inventories {
+		model.Inventories = append(model.Inventories, ProjectBackupInventoryModel{
+			Name:      types.StringValue(inventory.Name),
+			Type:      types.StringValue(inventory.Type),
+			Inventory: types.StringValue(inventory.Inventory),
+			SSHKey:    types.StringValue(inventory.SSHKey),
+			BecomeKey: types.StringValue(inventory.BecomeKey),
+		})
+	}
+
+	for _, environment := range backup.Environments {
+		model.Environments = append(model.Environments, ProjectBackupEnvironmentModel{
+			Name: types.StringValue(environment.Name),
+			JSON: types.StringValue(environment.JSON),
+			Env:  types.StringValue(environment.Env),
+		})
+	}
+
+	for _, view := range backup.Views {
+		position := types.Int64Value(0)
+		if view.Position != nil {
+			position = types.Int64PointerValue(view.Position)
+		}
+		model.Views = append(model.Views, ProjectBackupViewModel{
+			Title:    types.StringValue(view.Title),
+			Position: position,
+		})
+	}
+
+	for _, template := range backup.Templates {
+		templateModel := ProjectBackupTemplateModel{
+			Name:                      types.StringValue(template.Name),
+			Type:                      types.StringValue(template.Type),
+			Description:               types.StringValue(template.Description),
+			Playbook:                  types.StringValue(template.Playbook),
+			Arguments:                 types.StringValue(template.Arguments),
+			Repository:                types.StringValue(template.Repository),
+			Inventory:                 types.StringValue(template.Inventory),
+			Environment:               types.StringValue(template.Environment),
+			VaultKey:                  types.StringValue(template.VaultKey),
+			View:                      types.StringValue(template.View),
+			BuildTemplate:             types.StringValue(template.BuildTemplate),
+			StartVersion:              types.StringValue(template.StartVersion),
+			Autorun:                   types.BoolValue(template.Autorun),
+			Cron:                      types.StringValue(template.Cron),
+			SuppressSuccessAlerts:     types.BoolValue(template.SuppressSuccessAlerts),
+			AllowOverrideArgsInTask:   types.BoolValue(template.AllowOverrideArgsInTask),
+			AllowOverrideBranchInTask: types.BoolValue(template.AllowOverrideBranchInTask),
+		}
+		for _, surveyVar := range template.SurveyVars {
+			templateModel.SurveyVars = append(templateModel.SurveyVars, ProjectBackupTemplateSurveyVarModel{
+				Name:        types.StringValue(surveyVar.Name),
+				Title:       types.StringValue(surveyVar.Title),
+				Description: types.StringValue(surveyVar.Description),
+				Type:        types.StringValue(surveyVar.Type),
+				Required:    types.BoolValue(surveyVar.Required),
+			})
+		}
+		model.Templates = append(model.Templates, templateModel)
+	}
+
+	return model, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectBackupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectBackupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Project.GetProjectProjectIDBackup(&project.GetProjectProjectIDBackupParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Backup", "Could not read project backup", err)...)
+		return
+	}
+
+	model, err := convertProjectBackupResponseToProjectBackupModel(config.ProjectID.ValueInt64(), response.Payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Encoding SemaphoreUI Project Backup",
+			"Could not encode project backup as JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectBackupDataSourceConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "semaphoreui_project_backup" "test" {
+  project_id = semaphoreui_project.test.id
+
+  depends_on = [semaphoreui_project_template.test]
+}
+`, testAccProjectTemplateConfig(nameSuffix, ""))
+}
+
+func TestAcc_ProjectBackupDataSource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectBackupDataSourceConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.semaphoreui_project_backup.test", "json"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_backup.test", "meta.name", "semaphoreui_project.test", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.semaphoreui_project_backup.test", "templates.*", map[string]string{
+						"name":     fmt.Sprintf("Test %s", nameSuffix),
+						"playbook": "playbook.yml",
+					}),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_backup.test", "repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_backup.test", "environments.#", "1"),
+				),
+			},
+		},
+	})
+}
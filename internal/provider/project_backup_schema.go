@@ -0,0 +1,217 @@
+package provider
+
+import (
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type ProjectBackupModel struct {
+	ProjectID    types.Int64                     `tfsdk:"project_id"`
+	JSON         types.String                    `tfsdk:"json"`
+	Meta         *ProjectBackupMetaModel         `tfsdk:"meta"`
+	Keys         []ProjectBackupKeyModel         `tfsdk:"keys"`
+	Repositories []ProjectBackupRepositoryModel  `tfsdk:"repositories"`
+	Inventories  []ProjectBackupInventoryModel   `tfsdk:"inventories"`
+	Environments []ProjectBackupEnvironmentModel `tfsdk:"environments"`
+	Views        []ProjectBackupViewModel        `tfsdk:"views"`
+	Templates    []ProjectBackupTemplateModel    `tfsdk:"templates"`
+}
+
+type ProjectBackupMetaModel struct {
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Alert            types.Bool   `tfsdk:"alert"`
+	AlertChat        types.String `tfsdk:"alert_chat"`
+	MaxParallelTasks types.Int64  `tfsdk:"max_parallel_tasks"`
+}
+
+type ProjectBackupKeyModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+type ProjectBackupRepositoryModel struct {
+	Name      types.String `tfsdk:"name"`
+	GitURL    types.String `tfsdk:"git_url"`
+	GitBranch types.String `tfsdk:"git_branch"`
+	SSHKey    types.String `tfsdk:"ssh_key"`
+}
+
+type ProjectBackupInventoryModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Inventory types.String `tfsdk:"inventory"`
+	SSHKey    types.String `tfsdk:"ssh_key"`
+	BecomeKey types.String `tfsdk:"become_key"`
+}
+
+type ProjectBackupEnvironmentModel struct {
+	Name types.String `tfsdk:"name"`
+	JSON types.String `tfsdk:"json"`
+	Env  types.String `tfsdk:"env"`
+}
+
+type ProjectBackupViewModel struct {
+	Title    types.String `tfsdk:"title"`
+	Position types.Int64  `tfsdk:"position"`
+}
+
+type ProjectBackupTemplateModel struct {
+	Name                      types.String                          `tfsdk:"name"`
+	Type                      types.String                          `tfsdk:"type"`
+	Description               types.String                          `tfsdk:"description"`
+	Playbook                  types.String                          `tfsdk:"playbook"`
+	Arguments                 types.String                          `tfsdk:"arguments"`
+	Repository                types.String                          `tfsdk:"repository"`
+	Inventory                 types.String                          `tfsdk:"inventory"`
+	Environment               types.String                          `tfsdk:"environment"`
+	VaultKey                  types.String                          `tfsdk:"vault_key"`
+	View                      types.String                          `tfsdk:"view"`
+	BuildTemplate             types.String                          `tfsdk:"build_template"`
+	StartVersion              types.String                          `tfsdk:"start_version"`
+	Autorun                   types.Bool                            `tfsdk:"autorun"`
+	Cron                      types.String                          `tfsdk:"cron"`
+	SuppressSuccessAlerts     types.Bool                            `tfsdk:"suppress_success_alerts"`
+	AllowOverrideArgsInTask   types.Bool                            `tfsdk:"allow_override_args_in_task"`
+	AllowOverrideBranchInTask types.Bool                            `tfsdk:"allow_override_branch_in_task"`
+	SurveyVars                []ProjectBackupTemplateSurveyVarModel `tfsdk:"survey_vars"`
+}
+
+type ProjectBackupTemplateSurveyVarModel struct {
+	Name        types.String `tfsdk:"name"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Required    types.Bool   `tfsdk:"required"`
+}
+
+// The helpers below return the computed attributes that describe the objects
+// of the backup.
+func projectBackupStringAttribute(description string) superschema.StringAttribute {
+	return superschema.StringAttribute{
+		DataSource: &schemaD.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		},
+	}
+}
+
+func projectBackupBoolAttribute(description string) superschema.BoolAttribute {
+	return superschema.BoolAttribute{
+		DataSource: &schemaD.BoolAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		},
+	}
+}
+
+func projectBackupInt64Attribute(description string) superschema.Int64Attribute {
+	return superschema.Int64Attribute{
+		DataSource: &schemaD.Int64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		},
+	}
+}
+
+func projectBackupListAttribute(description string, attributes map[string]superschema.Attribute) superschema.ListNestedAttribute {
+	return superschema.ListNestedAttribute{
+		DataSource: &schemaD.ListNestedAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		},
+		Attributes: attributes,
+	}
+}
+
+func ProjectBackupSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project backup",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source allows you to export a project as a backup, both as JSON that can be restored into SemaphoreUI and as structured attributes. Objects in the backup reference each other by name, and secrets (such as key material) are not included.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"project_id": superschema.Int64Attribute{
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: "The ID of the project to back up.",
+					Required:            true,
+				},
+			},
+			"json": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The backup as canonical JSON, suitable for archiving or for restoring into SemaphoreUI. Marked sensitive because it may contain environment secrets.",
+					Computed:            true,
+					Sensitive:           true,
+				},
+			},
+			"meta": superschema.SingleNestedAttribute{
+				DataSource: &schemaD.SingleNestedAttribute{
+					MarkdownDescription: "The project settings.",
+					Computed:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"name":               projectBackupStringAttribute("The name of the project."),
+					"type":               projectBackupStringAttribute("The type of the project."),
+					"alert":              projectBackupBoolAttribute("Whether alerts are enabled for the project."),
+					"alert_chat":         projectBackupStringAttribute("The chat that alerts are sent to."),
+					"max_parallel_tasks": projectBackupInt64Attribute("The maximum number of tasks run in parallel."),
+				},
+			},
+			"keys": projectBackupListAttribute("The access keys of the project.", map[string]superschema.Attribute{
+				"name": projectBackupStringAttribute("The name of the key."),
+				"type": projectBackupStringAttribute("The type of the key (e.g. `none`, `ssh`, `login_password`)."),
+			}),
+			"repositories": projectBackupListAttribute("The repositories of the project.", map[string]superschema.Attribute{
+				"name":       projectBackupStringAttribute("The name of the repository."),
+				"git_url":    projectBackupStringAttribute("The URL of the repository."),
+				"git_branch": projectBackupStringAttribute("The branch of the repository."),
+				"ssh_key":    projectBackupStringAttribute("The name of the key used to access the repository."),
+			}),
+			"inventories": projectBackupListAttribute("The inventories of the project.", map[string]superschema.Attribute{
+				"name":       projectBackupStringAttribute("The name of the inventory."),
+				"type":       projectBackupStringAttribute("The type of the inventory (e.g. `static`, `file`)."),
+				"inventory":  projectBackupStringAttribute("The inventory content, or the path of an inventory file."),
+				"ssh_key":    projectBackupStringAttribute("The name of the key used to connect to hosts."),
+				"become_key": projectBackupStringAttribute("The name of the key used for privilege escalation."),
+			}),
+			"environments": projectBackupListAttribute("The variable groups (environments) of the project.", map[string]superschema.Attribute{
+				"name": projectBackupStringAttribute("The name of the environment."),
+				"json": projectBackupStringAttribute("The extra variables of the environment, as JSON."),
+				"env":  projectBackupStringAttribute("The environment variables of the environment, as JSON."),
+			}),
+			"views": projectBackupListAttribute("The views of the project.", map[string]superschema.Attribute{
+				"title":    projectBackupStringAttribute("The title of the view."),
+				"position": projectBackupInt64Attribute("The position of the view."),
+			}),
+			"templates": projectBackupListAttribute("The templates of the project.", map[string]superschema.Attribute{
+				"name":                          projectBackupStringAttribute("The name of the template."),
+				"type":                          projectBackupStringAttribute("The type of the template (`build`, `deploy` or empty for a task)."),
+				"description":                   projectBackupStringAttribute("The description of the template."),
+				"playbook":                      projectBackupStringAttribute("The playbook or script run by the template."),
+				"arguments":                     projectBackupStringAttribute("The command-line arguments of the template, as JSON."),
+				"repository":                    projectBackupStringAttribute("The name of the repository used by the template."),
+				"inventory":                     projectBackupStringAttribute("The name of the inventory used by the template."),
+				"environment":                   projectBackupStringAttribute("The name of the environment used by the template."),
+				"vault_key":                     projectBackupStringAttribute("The name of the key used as vault password."),
+				"view":                          projectBackupStringAttribute("The title of the view the template is shown in."),
+				"build_template":                projectBackupStringAttribute("The name of the build template of a deploy template."),
+				"start_version":                 projectBackupStringAttribute("The start version of a build template."),
+				"autorun":                       projectBackupBoolAttribute("Whether a deploy template runs automatically after its build template."),
+				"cron":                          projectBackupStringAttribute("The cron schedule of the template."),
+				"suppress_success_alerts":       projectBackupBoolAttribute("Whether alerts are suppressed for successful tasks."),
+				"allow_override_args_in_task":   projectBackupBoolAttribute("Whether tasks may override the command-line arguments."),
+				"allow_override_branch_in_task": projectBackupBoolAttribute("Whether tasks may override the repository branch."),
+				"survey_vars": projectBackupListAttribute("The survey variables of the template.", map[string]superschema.Attribute{
+					"name":        projectBackupStringAttribute("The name of the survey variable."),
+					"title":       projectBackupStringAttribute("The title of the survey variable."),
+					"description": projectBackupStringAttribute("The description of the survey variable."),
+					"type":        projectBackupStringAttribute("The type of the survey variable."),
+					"required":    projectBackupBoolAttribute("Whether the survey variable is required."),
+				}),
+			}),
+		},
+	}
+}
@@ -27,15 +27,15 @@ func (d *projectDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -51,7 +51,7 @@ func (d *projectDataSource) Schema(ctx context.Context, _ datasource.SchemaReque
 func (d *projectDataSource) GetProjectByName(name string) (*ProjectModel, error) {
 	response, err := d.client.Project.GetProjects(&project.GetProjectsParams{}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Projects: %s", err.Error())
+		return nil, fmt.Errorf("could not read Projects: %w", err)
 	}
 
 	for _, project := range response.Payload {
@@ -76,20 +76,14 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			ProjectID: config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Semaphore Project",
-				fmt.Sprintf("Could not read project: %s", err.Error()),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project", "Could not read project", err)...)
 			return
 		}
 		model = convertProjectResponseToProjectModel(response.Payload)
 	} else if !config.Name.IsUnknown() && !config.Name.IsNull() {
 		proj, err := d.GetProjectByName(config.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Semaphore Project",
-				fmt.Sprintf("Could not read project: %s", err.Error()),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project", "Could not read project", err)...)
 			return
 		}
 		model = *proj
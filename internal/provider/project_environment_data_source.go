@@ -26,15 +26,15 @@ func (d *projectEnvironmentDataSource) Configure(_ context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -59,10 +59,7 @@ func (d *projectEnvironmentDataSource) Read(ctx context.Context, req datasource.
 		EnvironmentID: config.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Environment",
-			"Could not read project environment, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Environment", "Could not read project environment", err)...)
 		return
 	}
 	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &config)
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"sort"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/variable_group"
@@ -34,37 +33,37 @@ func (r *projectEnvironmentResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project_environment"
 }
 
-func (model ProjectEnvironmentModel) SecretValue(ctx context.Context, name string, varType string) types.String {
+func (model ProjectEnvironmentModel) SecretByName(ctx context.Context, name string, varType string) *ProjectEnvironmentSecretModel {
 	if model.Secrets.IsNull() || model.Secrets.IsUnknown() {
-		return types.StringValue("")
+		return nil
 	}
 	var secrets []ProjectEnvironmentSecretModel
 	diags := model.Secrets.ElementsAs(ctx, &secrets, false)
 	if diags.HasError() {
-		return types.StringValue("")
+		return nil
 	}
 	for _, secret := range secrets {
 		if secret.Name.Equal(types.StringValue(name)) && secret.Type.Equal(types.StringValue(varType)) {
-			return secret.Value
+			return &secret
 		}
 	}
-	return types.StringValue("")
+	return nil
 }
 
 func (model ProjectEnvironmentModel) Secret(ctx context.Context, id types.Int64) *ProjectEnvironmentSecretModel {
@@ -90,7 +89,30 @@ func (r *projectEnvironmentResource) Schema(ctx context.Context, _ resource.Sche
 	resp.Schema = ProjectEnvironmentSchema().GetResource(ctx)
 }
 
-func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env ProjectEnvironmentModel, prev *ProjectEnvironmentModel) *models.EnvironmentRequest {
+// resolveSecretValues returns the plaintext values of the secrets of the
+// plan, in the same order. Each value comes from either `value` or its
+// write-only counterpart `value_wo`, which is only available in the config.
+func resolveSecretValues(ctx context.Context, plan, config *ProjectEnvironmentModel) []string {
+	var planSecrets, configSecrets []ProjectEnvironmentSecretModel
+	if !plan.Secrets.IsNull() && !plan.Secrets.IsUnknown() {
+		plan.Secrets.ElementsAs(ctx, &planSecrets, false)
+	}
+	if !config.Secrets.IsNull() && !config.Secrets.IsUnknown() {
+		config.Secrets.ElementsAs(ctx, &configSecrets, false)
+	}
+
+	values := make([]string, len(planSecrets))
+	for i, secret := range planSecrets {
+		values[i] = secret.Value.ValueString()
+		// The plan is built from the config, so the secrets are in the same order.
+		if i < len(configSecrets) && !configSecrets[i].ValueWO.IsNull() && !configSecrets[i].ValueWO.IsUnknown() {
+			values[i] = configSecrets[i].ValueWO.ValueString()
+		}
+	}
+	return values
+}
+
+func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env ProjectEnvironmentModel, values []string, prev *ProjectEnvironmentModel) *models.EnvironmentRequest {
 	model := models.EnvironmentRequest{
 		ProjectID: env.ProjectID.ValueInt64(),
 		Name:      env.Name.ValueString(),
@@ -126,7 +148,11 @@ func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env
 		prev.Secrets.ElementsAs(ctx, &prevSecrets, false)
 	}
 
-	for _, secret := range envSecrets {
+	for i, secret := range envSecrets {
+		var value string
+		if i < len(values) {
+			value = values[i]
+		}
 		modelSecret := models.EnvironmentSecretRequest{
 			Name: secret.Name.ValueString(),
 			Type: secret.Type.ValueString(),
@@ -134,7 +160,7 @@ func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env
 		// Create all secrets from env missing an ID
 		if secret.ID.IsUnknown() || secret.ID.IsNull() {
 			modelSecret.Operation = "create"
-			modelSecret.Secret = secret.Value.ValueString()
+			modelSecret.Secret = value
 		} else {
 			modelSecret.ID = secret.ID.ValueInt64()
 			// Find the previous secret
@@ -145,13 +171,15 @@ func convertProjectEnvironmentModelToEnvironmentRequest(ctx context.Context, env
 				// name/secret are persisted. The schema treats `type` as
 				// RequiresReplace on the secret list element to prevent the silent
 				// no-op.
-				if !secret.Name.Equal(prevSecret.Name) || !secret.Value.Equal(prevSecret.Value) || !secret.Type.Equal(prevSecret.Type) {
+				// A write-only value is only sent when its version changes.
+				valueChanged := !secret.Value.Equal(prevSecret.Value) || !secret.ValueWOVersion.Equal(prevSecret.ValueWOVersion)
+				if !secret.Name.Equal(prevSecret.Name) || valueChanged || !secret.Type.Equal(prevSecret.Type) {
 					modelSecret.Operation = "update"
 					if !secret.Name.Equal(prevSecret.Name) {
 						modelSecret.Name = secret.Name.ValueString()
 					}
-					if !secret.Value.Equal(prevSecret.Value) {
-						modelSecret.Secret = secret.Value.ValueString()
+					if valueChanged {
+						modelSecret.Secret = value
 					}
 					if !secret.Type.Equal(prevSecret.Type) {
 						modelSecret.Type = secret.Type.ValueString()
@@ -214,16 +242,21 @@ func convertEnvironmentResponseToProjectEnvironmentModel(ctx context.Context, en
 	var secrets []ProjectEnvironmentSecretModel
 	for _, secret := range environment.Secrets {
 		modelSecret := ProjectEnvironmentSecretModel{
-			ID:   types.Int64Value(secret.ID),
-			Type: types.StringValue(secret.Type),
-			Name: types.StringValue(secret.Name),
+			ID:             types.Int64Value(secret.ID),
+			Type:           types.StringValue(secret.Type),
+			Name:           types.StringValue(secret.Name),
+			Value:          types.StringValue(""),
+			ValueWO:        types.StringNull(),
+			ValueWOVersion: types.Int64Null(),
 		}
 		// Value from previous state since secrets are not returned in the response
 		prevSecret := prev.Secret(ctx, modelSecret.ID)
+		if prevSecret == nil {
+			prevSecret = prev.SecretByName(ctx, secret.Name, secret.Type)
+		}
 		if prevSecret != nil {
 			modelSecret.Value = prevSecret.Value
-		} else {
-			modelSecret.Value = prev.SecretValue(ctx, secret.Name, secret.Type)
+			modelSecret.ValueWOVersion = prevSecret.ValueWOVersion
 		}
 		secrets = append(secrets, modelSecret)
 	}
@@ -233,10 +266,12 @@ func convertEnvironmentResponseToProjectEnvironmentModel(ctx context.Context, en
 
 	envSecrets, _ := types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
-			"id":    types.Int64Type,
-			"type":  types.StringType,
-			"name":  types.StringType,
-			"value": types.StringType,
+			"id":               types.Int64Type,
+			"type":             types.StringType,
+			"name":             types.StringType,
+			"value":            types.StringType,
+			"value_wo":         types.StringType,
+			"value_wo_version": types.Int64Type,
 		},
 	}, secrets)
 
@@ -246,9 +281,11 @@ func convertEnvironmentResponseToProjectEnvironmentModel(ctx context.Context, en
 }
 
 func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Retrieve values from plan
-	var plan ProjectEnvironmentModel
+	// Retrieve values from plan + config. WriteOnly attributes (value_wo)
+	// live in Config only.
+	var plan, config ProjectEnvironmentModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -256,13 +293,10 @@ func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 	//Create new projectEnvironment
 	response, err := r.client.VariableGroup.PostProjectProjectIDEnvironment(&variable_group.PostProjectProjectIDEnvironmentParams{
 		ProjectID:   plan.ProjectID.ValueInt64(),
-		Environment: convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan, &ProjectEnvironmentModel{}),
+		Environment: convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan, resolveSecretValues(ctx, &plan, &config), &ProjectEnvironmentModel{}),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Environment",
-			"Could not create project environment, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Environment", "Could not create project environment", err)...)
 		return
 	}
 
@@ -271,10 +305,7 @@ func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 		EnvironmentID: response.Payload.ID,
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Environment",
-			"Could not read project environment, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Environment", "Could not read project environment", err)...)
 		return
 	}
 	plan = convertEnvironmentResponseToProjectEnvironmentModel(ctx, payload.Payload, &plan)
@@ -300,10 +331,12 @@ func (r *projectEnvironmentResource) Read(ctx context.Context, req resource.Read
 		EnvironmentID: state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Environment",
-			"Could not read project environment, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project environment deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Environment", "Could not read project environment", err)...)
 		return
 	}
 	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &state)
@@ -317,9 +350,11 @@ func (r *projectEnvironmentResource) Read(ctx context.Context, req resource.Read
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan, state ProjectEnvironmentModel
+	// Retrieve values from plan, config, and state. WriteOnly values are in
+	// Config only.
+	var plan, config, state ProjectEnvironmentModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -328,13 +363,10 @@ func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.Up
 	_, err := r.client.VariableGroup.PutProjectProjectIDEnvironmentEnvironmentID(&variable_group.PutProjectProjectIDEnvironmentEnvironmentIDParams{
 		ProjectID:     plan.ProjectID.ValueInt64(),
 		EnvironmentID: plan.ID.ValueInt64(),
-		Environment:   convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan, &state),
+		Environment:   convertProjectEnvironmentModelToEnvironmentRequest(ctx, plan, resolveSecretValues(ctx, &plan, &config), &state),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Key",
-			"Could not update project key, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Key", "Could not update project key", err)...)
 		return
 	}
 
@@ -343,10 +375,7 @@ func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.Up
 		EnvironmentID: plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Environment",
-			"Could not read project environment, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Environment", "Could not read project environment", err)...)
 		return
 	}
 	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &plan)
@@ -370,22 +399,16 @@ func (r *projectEnvironmentResource) Delete(ctx context.Context, req resource.De
 		ProjectID:     state.ProjectID.ValueInt64(),
 		EnvironmentID: state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Semaphore Project Environment",
-			fmt.Sprintf("Could not delete project environment, unexpected error: %s", err.Error()),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting Semaphore Project Environment", "Could not delete project environment", err)...)
 		return
 	}
 }
 
 func (r *projectEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "environment"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "environment"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Environment Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Environment Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -394,10 +417,7 @@ func (r *projectEnvironmentResource) ImportState(ctx context.Context, req resour
 		EnvironmentID: fields["environment"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Environment",
-			"Could not read project environment, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Environment", "Could not read project environment", err)...)
 		return
 	}
 	model := convertEnvironmentResponseToProjectEnvironmentModel(ctx, response.Payload, &ProjectEnvironmentModel{})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/variable_group"
 	"testing"
@@ -401,3 +402,112 @@ func TestAcc_ProjectEnvironmentResource_basicSecrets(t *testing.T) {
 		},
 	})
 }
+
+func testAccProjectEnvironmentSecretWOConfig(nameSuffix string, value string, version int) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_environment" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Test %[2]s"
+  secrets = [
+    {
+      name             = "TOKEN"
+      type             = "env"
+      value_wo         = "%[3]s"
+      value_wo_version = %[4]d
+    },
+  ]
+}`, testAccProjectEnvironmentEmptyConfig(nameSuffix), nameSuffix, value, version)
+}
+
+// Write-only secret flow: the value never appears in state, and a
+// value_wo_version bump pushes the new value to the API.
+func TestAcc_ProjectEnvironmentResource_writeOnlySecrets(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectEnvironmentSecretWOConfig(nameSuffix, "s3cret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentExists("semaphoreui_project_environment.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.#", "1"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_environment.test", "secrets.0.id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.name", "TOKEN"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.value_wo_version", "1"),
+					// Write-only values never appear in state.
+					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "secrets.0.value_wo"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_environment.test", "secrets.0.value"),
+				),
+			},
+			{
+				Config: testAccProjectEnvironmentSecretWOConfig(nameSuffix, "rotated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.#", "1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_environment.test", "secrets.0.value_wo_version", "2"),
+				),
+			},
+			{
+				Config: testAccProjectEnvironmentEmptyConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceNotExists("semaphoreui_project_environment.test"),
+				),
+			},
+		},
+	})
+}
+
+// Negative test: a secret needs exactly one of value and value_wo.
+func TestAcc_ProjectEnvironmentResource_writeOnlySecretsMutexRejected(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_environment" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Test %[2]s"
+  secrets = [
+    {
+      name     = "TOKEN"
+      type     = "env"
+      value    = "persisted"
+      value_wo = "ephemeral"
+    },
+  ]
+}`, testAccProjectEnvironmentEmptyConfig(nameSuffix), nameSuffix),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+// TestAcc_ProjectEnvironmentResource_disappears verifies that a project environment deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectEnvironmentResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectEnvironmentConfig(nameSuffix, nil, nil, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectEnvironmentExists("semaphoreui_project_environment.test"),
+					testAccDeleteOutOfBand("semaphoreui_project_environment.test", func(attributes map[string]string) error {
+						_, err := testClient().VariableGroup.DeleteProjectProjectIDEnvironmentEnvironmentID(&variable_group.DeleteProjectProjectIDEnvironmentEnvironmentIDParams{
+							ProjectID:     testAccInt64Attribute(attributes, "project_id"),
+							EnvironmentID: testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
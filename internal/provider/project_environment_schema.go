@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,10 +23,12 @@ type (
 	}
 
 	ProjectEnvironmentSecretModel struct {
-		ID    types.Int64  `tfsdk:"id"`
-		Type  types.String `tfsdk:"type"`
-		Name  types.String `tfsdk:"name"`
-		Value types.String `tfsdk:"value"`
+		ID             types.Int64  `tfsdk:"id"`
+		Type           types.String `tfsdk:"type"`
+		Name           types.String `tfsdk:"name"`
+		Value          types.String `tfsdk:"value"`
+		ValueWO        types.String `tfsdk:"value_wo"`
+		ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
 	}
 )
 
@@ -144,16 +147,40 @@ func ProjectEnvironmentSchema() superschema.Schema {
 					},
 					"value": superschema.StringAttribute{
 						Common: &schemaR.StringAttribute{
-							MarkdownDescription: "The variable value.",
+							MarkdownDescription: "The variable value. Persisted to Terraform state. Set exactly one of `value` or `value_wo`.",
 							Sensitive:           true,
 						},
 						Resource: &schemaR.StringAttribute{
-							Required: true,
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_wo")),
+							},
 						},
 						DataSource: &schemaD.StringAttribute{
 							Computed: true,
 						},
 					},
+					"value_wo": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "Write-only variant of `value` — accepts ephemeral values (e.g. from `vault_kv_secret_v2`) and is never persisted to Terraform state. Mutually exclusive with `value`. Bump `value_wo_version` to push a new value to SemaphoreUI.",
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+					"value_wo_version": superschema.Int64Attribute{
+						Resource: &schemaR.Int64Attribute{
+							MarkdownDescription: "Version trigger for `value_wo`. Increment to instruct the provider to re-read the write-only value and push it to SemaphoreUI. Only meaningful when `value_wo` is set.",
+							Optional:            true,
+						},
+						DataSource: &schemaD.Int64Attribute{
+							Computed: true,
+						},
+					},
 				},
 			},
 		},
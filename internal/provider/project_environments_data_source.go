@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/variable_group"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectEnvironmentsDataSource{}
+)
+
+func NewProjectEnvironmentsDataSource() datasource.DataSource {
+	return &projectEnvironmentsDataSource{}
+}
+
+type projectEnvironmentsDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectEnvironmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectEnvironmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environments"
+}
+
+type projectEnvironmentsDataSourceModel struct {
+	ProjectID    types.Int64               `tfsdk:"project_id"`
+	NameRegex    types.String              `tfsdk:"name_regex"`
+	Environments []ProjectEnvironmentModel `tfsdk:"environments"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectEnvironmentsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Environments of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("environments"),
+			"name_regex": nameRegexAttribute("environments", "name"),
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the environments that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: readOnlyAttributes(ProjectEnvironmentSchema().GetDataSource(ctx).Attributes),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectEnvironmentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.VariableGroup.GetProjectProjectIDEnvironment(&variable_group.GetProjectProjectIDEnvironmentParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Environments", "Could not read project environments", err)...)
+		return
+	}
+
+	config.Environments = []ProjectEnvironmentModel{}
+	for _, environment := range response.Payload {
+		if !matchesName(environment.Name) {
+			continue
+		}
+		config.Environments = append(config.Environments, convertEnvironmentResponseToProjectEnvironmentModel(ctx, environment, &ProjectEnvironmentModel{}))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
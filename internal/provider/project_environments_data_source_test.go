@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectEnvironmentsDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Environments DS Project"
+}
+
+resource "semaphoreui_project_environment" "production" {
+  project_id = semaphoreui_project.test.id
+  name       = "Production"
+  variables = {
+    stage = "production"
+  }
+  secrets = [{
+    name  = "TOKEN"
+    type  = "env"
+    value = "secret"
+  }]
+}
+
+resource "semaphoreui_project_environment" "staging" {
+  project_id = semaphoreui_project.test.id
+  name       = "Staging"
+}
+
+data "semaphoreui_project_environments" "test" {
+  project_id = semaphoreui_project.test.id
+  name_regex = "^Prod"
+  depends_on = [semaphoreui_project_environment.production, semaphoreui_project_environment.staging]
+}`
+}
+
+func TestAcc_ProjectEnvironmentsDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectEnvironmentsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environments.test", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environments.test", "environments.0.name", "Production"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environments.test", "environments.0.variables.stage", "production"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environments.test", "environments.0.secrets.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_environments.test", "environments.0.secrets.0.name", "TOKEN"),
+				),
+			},
+		},
+	})
+}
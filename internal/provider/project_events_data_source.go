@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectEventsDataSource{}
+)
+
+func NewProjectEventsDataSource() datasource.DataSource {
+	return &projectEventsDataSource{}
+}
+
+type projectEventsDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_events"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectEventsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ProjectEventsSchema().GetDataSource(ctx)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectEventsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Project.GetProjectProjectIDEvents(&project.GetProjectProjectIDEventsParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Events", fmt.Sprintf("Could not read events of project ID %d", config.ProjectID.ValueInt64()), err)...)
+		return
+	}
+
+	events, diags := filterEvents(response.Payload, config.ObjectType, config.ObjectID, config.UserID, config.Last)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Events = events
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
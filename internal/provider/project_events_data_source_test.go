@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectEventsDataSourceConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "Test %[1]s"
+}
+
+data "semaphoreui_project_events" "test" {
+  project_id  = semaphoreui_project.test.id
+  object_type = "project"
+}
+`, nameSuffix)
+}
+
+func TestAcc_ProjectEventsDataSource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectEventsDataSourceConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.semaphoreui_project_events.test", "events.0.description"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_events.test", "events.0.object_type", "project"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_events.test", "events.0.project_id", "semaphoreui_project.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -25,15 +25,15 @@ func (d *projectIntegrationDataSource) Configure(_ context.Context, req datasour
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 func (d *projectIntegrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,7 +49,7 @@ func (d *projectIntegrationDataSource) GetIntegrationByName(ctx context.Context,
 		ProjectID: projectID,
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read project integrations: %s", err.Error())
+		return nil, fmt.Errorf("could not read project integrations: %w", err)
 	}
 	for _, integ := range response.Payload {
 		if integ.Name == name {
@@ -74,20 +74,14 @@ func (d *projectIntegrationDataSource) Read(ctx context.Context, req datasource.
 			IntegrationID: config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Integration",
-				"Could not read project integration, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration", "Could not read project integration", err)...)
 			return
 		}
 		model = convertIntegrationResponseToProjectIntegrationModel(ctx, response.Payload)
 	} else if !config.Name.IsUnknown() && !config.Name.IsNull() {
 		integ, err := d.GetIntegrationByName(ctx, config.ProjectID.ValueInt64(), config.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Integration",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration", "", err)...)
 			return
 		}
 		model = *integ
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+var (
+	_ resource.Resource                = &projectIntegrationExtractValueResource{}
+	_ resource.ResourceWithConfigure   = &projectIntegrationExtractValueResource{}
+	_ resource.ResourceWithImportState = &projectIntegrationExtractValueResource{}
+)
+
+func NewProjectIntegrationExtractValueResource() resource.Resource {
+	return &projectIntegrationExtractValueResource{}
+}
+
+type projectIntegrationExtractValueResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectIntegrationExtractValueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *projectIntegrationExtractValueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_integration_extract_value"
+}
+
+func (r *projectIntegrationExtractValueResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectIntegrationExtractValueSchema().GetResource(ctx)
+}
+
+func convertProjectIntegrationExtractValueModelToIntegrationExtractValue(model ProjectIntegrationExtractValueModel) *models.IntegrationExtractValue {
+	return &models.IntegrationExtractValue{
+		IntegrationID: model.IntegrationID.ValueInt64(),
+		Name:          model.Name.ValueString(),
+		ValueSource:   model.ValueSource.ValueString(),
+		BodyDataType:  model.BodyDataType.ValueString(),
+		Key:           model.Key.ValueString(),
+		Variable:      model.Variable.ValueString(),
+		VariableType:  model.VariableType.ValueString(),
+	}
+}
+
+func convertProjectIntegrationExtractValueModelToIntegrationExtractValueRequest(model ProjectIntegrationExtractValueModel) *models.IntegrationExtractValueRequest {
+	return &models.IntegrationExtractValueRequest{
+		Name:         model.Name.ValueString(),
+		ValueSource:  model.ValueSource.ValueString(),
+		BodyDataType: model.BodyDataType.ValueString(),
+		Key:          model.Key.ValueString(),
+		Variable:     model.Variable.ValueString(),
+		VariableType: model.VariableType.ValueString(),
+	}
+}
+
+// convertIntegrationExtractValueResponseToProjectIntegrationExtractValueModel
+// takes the project and integration IDs from the caller, as extract value
+// payloads only carry their own ID.
+func convertIntegrationExtractValueResponseToProjectIntegrationExtractValueModel(projectID, integrationID int64, payload *models.IntegrationExtractValue) ProjectIntegrationExtractValueModel {
+	return ProjectIntegrationExtractValueModel{
+		ID:            types.Int64Value(payload.ID),
+		ProjectID:     types.Int64Value(projectID),
+		IntegrationID: types.Int64Value(integrationID),
+		Name:          types.StringValue(payload.Name),
+		ValueSource:   types.StringValue(payload.ValueSource),
+		BodyDataType:  types.StringValue(payload.BodyDataType),
+		Key:           types.StringValue(payload.Key),
+		Variable:      types.StringValue(payload.Variable),
+		VariableType:  types.StringValue(payload.VariableType),
+	}
+}
+
+// listExtractValues returns every extract value defined on an integration. The
+// API has no GET-by-id for extract values, so all lookups go through this list.
+func (r *projectIntegrationExtractValueResource) listExtractValues(projectID, integrationID int64) ([]*models.IntegrationExtractValue, error) {
+	response, err := r.client.Integration.GetProjectProjectIDIntegrationsIntegrationIDValues(
+		&integration.GetProjectProjectIDIntegrationsIntegrationIDValuesParams{
+			ProjectID:     projectID,
+			IntegrationID: integrationID,
+		}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// findExtractValue looks up an extract value by ID. Returns nil (and no error)
+// when the extract value no longer exists.
+func (r *projectIntegrationExtractValueResource) findExtractValue(projectID, integrationID, extractValueID int64) (*models.IntegrationExtractValue, error) {
+	extractValues, err := r.listExtractValues(projectID, integrationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range extractValues {
+		if v.ID == extractValueID {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *projectIntegrationExtractValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectIntegrationExtractValueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueInt64()
+	integrationID := plan.IntegrationID.ValueInt64()
+
+	// The create endpoint does not return the new extract value, so remember
+	// which ones already exist and pick out the newcomer afterwards.
+	existing, err := r.listExtractValues(projectID, integrationID)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Extract Values", "Could not list project integration extract values", err)...)
+		return
+	}
+	known := make(map[int64]bool, len(existing))
+	for _, v := range existing {
+		known[v.ID] = true
+	}
+
+	_, err = r.client.Integration.PostProjectProjectIDIntegrationsIntegrationIDValues(
+		&integration.PostProjectProjectIDIntegrationsIntegrationIDValuesParams{
+			ProjectID:                 projectID,
+			IntegrationID:             integrationID,
+			IntegrationExtractedValue: convertProjectIntegrationExtractValueModelToIntegrationExtractValue(plan),
+		}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Integration Extract Value", "Could not create project integration extract value", err)...)
+		return
+	}
+
+	extractValues, err := r.listExtractValues(projectID, integrationID)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Extract Values", "Could not list project integration extract values after create", err)...)
+		return
+	}
+	var created *models.IntegrationExtractValue
+	for _, v := range extractValues {
+		if known[v.ID] || v.Name != plan.Name.ValueString() {
+			continue
+		}
+		if created == nil || v.ID > created.ID {
+			created = v
+		}
+	}
+	if created == nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Integration Extract Value",
+			fmt.Sprintf("The extract value %q was created but could not be found on integration %d afterwards.", plan.Name.ValueString(), integrationID),
+		)
+		return
+	}
+
+	model := convertIntegrationExtractValueResponseToProjectIntegrationExtractValueModel(projectID, integrationID, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *projectIntegrationExtractValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectIntegrationExtractValueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extractValue, err := r.findExtractValue(state.ProjectID.ValueInt64(), state.IntegrationID.ValueInt64(), state.ID.ValueInt64())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Extract Value", "Could not read project integration extract value", err)...)
+		return
+	}
+	if extractValue == nil {
+		// Drift: extract value deleted out-of-band. Remove from state.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model := convertIntegrationExtractValueResponseToProjectIntegrationExtractValueModel(state.ProjectID.ValueInt64(), state.IntegrationID.ValueInt64(), extractValue)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *projectIntegrationExtractValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectIntegrationExtractValueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Integration.PutProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueID(
+		&integration.PutProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueIDParams{
+			ProjectID:               plan.ProjectID.ValueInt64(),
+			IntegrationID:           plan.IntegrationID.ValueInt64(),
+			ExtractvalueID:          plan.ID.ValueInt64(),
+			IntegrationExtractValue: convertProjectIntegrationExtractValueModelToIntegrationExtractValueRequest(plan),
+		}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Integration Extract Value", "Could not update project integration extract value", err)...)
+		return
+	}
+
+	extractValue, err := r.findExtractValue(plan.ProjectID.ValueInt64(), plan.IntegrationID.ValueInt64(), plan.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Extract Value", "Could not read project integration extract value after update", err)...)
+		return
+	}
+	if extractValue == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Integration Extract Value",
+			fmt.Sprintf("Project integration extract value %d was not found after update.", plan.ID.ValueInt64()),
+		)
+		return
+	}
+
+	model := convertIntegrationExtractValueResponseToProjectIntegrationExtractValueModel(plan.ProjectID.ValueInt64(), plan.IntegrationID.ValueInt64(), extractValue)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *projectIntegrationExtractValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectIntegrationExtractValueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Integration.DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueID(
+		&integration.DeleteProjectProjectIDIntegrationsIntegrationIDValuesExtractvalueIDParams{
+			ProjectID:      state.ProjectID.ValueInt64(),
+			IntegrationID:  state.IntegrationID.ValueInt64(),
+			ExtractvalueID: state.ID.ValueInt64(),
+		}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project Integration Extract Value", "Could not remove project integration extract value", err)...)
+		return
+	}
+}
+
+func (r *projectIntegrationExtractValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "integration", "value"})
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Integration Extract Value Import ID", "Could not parse import ID", err)...)
+		return
+	}
+
+	extractValue, err := r.findExtractValue(fields["project"], fields["integration"], fields["value"])
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Extract Value", "Could not read project integration extract value during import", err)...)
+		return
+	}
+	if extractValue == nil {
+		resp.Diagnostics.AddError(
+			"Project Integration Extract Value Not Found",
+			fmt.Sprintf("No extract value with id=%d found on integration %d.", fields["value"], fields["integration"]),
+		)
+		return
+	}
+
+	model := convertIntegrationExtractValueResponseToProjectIntegrationExtractValueModel(fields["project"], fields["integration"], extractValue)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
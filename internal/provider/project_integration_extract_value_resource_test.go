@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccProjectIntegrationExtractValueExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.Attributes["id"] == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		id, _ := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		projectID, _ := strconv.ParseInt(rs.Primary.Attributes["project_id"], 10, 64)
+		integrationID, _ := strconv.ParseInt(rs.Primary.Attributes["integration_id"], 10, 64)
+
+		response, err := testClient().Integration.GetProjectProjectIDIntegrationsIntegrationIDValues(
+			&integration.GetProjectProjectIDIntegrationsIntegrationIDValuesParams{
+				ProjectID:     projectID,
+				IntegrationID: integrationID,
+			}, nil)
+		if err != nil {
+			return fmt.Errorf("could not list integration extract values: %s", err.Error())
+		}
+		for _, v := range response.Payload {
+			if v.ID != id {
+				continue
+			}
+			if v.Variable != rs.Primary.Attributes["variable"] {
+				return fmt.Errorf("extract value variable mismatch: %s != %s", v.Variable, rs.Primary.Attributes["variable"])
+			}
+			return nil
+		}
+		return fmt.Errorf("integration extract value %d not found under integration %d", id, integrationID)
+	}
+}
+
+func testAccProjectIntegrationExtractValueConfig(nameSuffix string, body string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_integration_extract_value" "test" {
+  project_id     = semaphoreui_project.test.id
+  integration_id = semaphoreui_project_integration.test.id
+  name           = "Value-%[2]s"
+  %[3]s
+}
+`, testAccProjectIntegrationConfig(nameSuffix, ""), nameSuffix, body)
+}
+
+func testAccProjectIntegrationExtractValueImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("project/%[1]s/integration/%[2]s/value/%[3]s",
+			rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["integration_id"],
+			rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestAcc_ProjectIntegrationExtractValueResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a body extract value with defaults.
+			{
+				Config: testAccProjectIntegrationExtractValueConfig(nameSuffix, `
+  value_source = "body"
+  key          = "head_commit.id"
+  variable     = "commit_sha"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExtractValueExists("semaphoreui_project_integration_extract_value.test"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_integration_extract_value.test", "id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "name", fmt.Sprintf("Value-%s", nameSuffix)),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "value_source", "body"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "body_data_type", "json"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "key", "head_commit.id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "variable", "commit_sha"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "variable_type", "task"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "semaphoreui_project_integration_extract_value.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccProjectIntegrationExtractValueImportID("semaphoreui_project_integration_extract_value.test"),
+			},
+			// Update to a header value exposed as an environment variable.
+			{
+				Config: testAccProjectIntegrationExtractValueConfig(nameSuffix, `
+  value_source  = "header"
+  key           = "X-GitHub-Delivery"
+  variable      = "DELIVERY_ID"
+  variable_type = "environment"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationExtractValueExists("semaphoreui_project_integration_extract_value.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "value_source", "header"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "key", "X-GitHub-Delivery"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "variable", "DELIVERY_ID"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_extract_value.test", "variable_type", "environment"),
+				),
+			},
+			// Delete
+			{
+				Config: testAccProjectIntegrationConfig(nameSuffix, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceNotExists("semaphoreui_project_integration_extract_value.test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+type ProjectIntegrationExtractValueModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ProjectID     types.Int64  `tfsdk:"project_id"`
+	IntegrationID types.Int64  `tfsdk:"integration_id"`
+	Name          types.String `tfsdk:"name"`
+	ValueSource   types.String `tfsdk:"value_source"`
+	BodyDataType  types.String `tfsdk:"body_data_type"`
+	Key           types.String `tfsdk:"key"`
+	Variable      types.String `tfsdk:"variable"`
+	VariableType  types.String `tfsdk:"variable_type"`
+}
+
+func ProjectIntegrationExtractValueSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project integration extract value",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to map a value from an incoming webhook request onto a task variable. The extracted value is passed to the integration's task as an extra variable or an environment variable.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The extract value ID.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+			"project_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The project ID that the integration belongs to.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"integration_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The integration ID that the extract value belongs to.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The display name of the extract value.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+			},
+			"value_source": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Where to read the value from: the request `body` or a request `header`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							models.IntegrationExtractValueValueSourceBody,
+							models.IntegrationExtractValueValueSourceHeader,
+						),
+					},
+				},
+			},
+			"body_data_type": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "How the request body is parsed to look up `key`. Only relevant when `value_source` is `body`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(models.IntegrationExtractValueBodyDataTypeJSON),
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							models.IntegrationExtractValueBodyDataTypeJSON,
+							models.IntegrationExtractValueBodyDataTypeXML,
+							models.IntegrationExtractValueBodyDataTypeString,
+						),
+					},
+				},
+			},
+			"key": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The header name, or the path into the request body (e.g. `head_commit.id` or `pull_request.number`), to read the value from.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+			},
+			"variable": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the variable the extracted value is assigned to.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+			},
+			"variable_type": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "How the variable is exposed to the task: as an extra variable (`task`) or as an `environment` variable.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(models.IntegrationExtractValueVariableTypeTask),
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							models.IntegrationExtractValueVariableTypeEnvironment,
+							models.IntegrationExtractValueVariableTypeTask,
+						),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+var (
+	_ resource.Resource                = &projectIntegrationMatcherResource{}
+	_ resource.ResourceWithConfigure   = &projectIntegrationMatcherResource{}
+	_ resource.ResourceWithImportState = &projectIntegrationMatcherResource{}
+)
+
+func NewProjectIntegrationMatcherResource() resource.Resource {
+	return &projectIntegrationMatcherResource{}
+}
+
+type projectIntegrationMatcherResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectIntegrationMatcherResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *projectIntegrationMatcherResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_integration_matcher"
+}
+
+func (r *projectIntegrationMatcherResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectIntegrationMatcherSchema().GetResource(ctx)
+}
+
+func convertProjectIntegrationMatcherModelToIntegrationMatcher(model ProjectIntegrationMatcherModel) *models.IntegrationMatcher {
+	return &models.IntegrationMatcher{
+		IntegrationID: model.IntegrationID.ValueInt64(),
+		Name:          model.Name.ValueString(),
+		MatchType:     model.MatchType.ValueString(),
+		Method:        model.Method.ValueString(),
+		BodyDataType:  model.BodyDataType.ValueString(),
+		Key:           model.Key.ValueString(),
+		Value:         model.Value.ValueString(),
+	}
+}
+
+func convertProjectIntegrationMatcherModelToIntegrationMatcherRequest(model ProjectIntegrationMatcherModel) *models.IntegrationMatcherRequest {
+	return &models.IntegrationMatcherRequest{
+		Name:         model.Name.ValueString(),
+		MatchType:    model.MatchType.ValueString(),
+		Method:       model.Method.ValueString(),
+		BodyDataType: model.BodyDataType.ValueString(),
+		Key:          model.Key.ValueString(),
+		Value:        model.Value.ValueString(),
+	}
+}
+
+// convertIntegrationMatcherResponseToProjectIntegrationMatcherModel takes the
+// project and integration IDs from the caller, as matcher payloads only carry
+// their own ID.
+func convertIntegrationMatcherResponseToProjectIntegrationMatcherModel(projectID, integrationID int64, payload *models.IntegrationMatcher) ProjectIntegrationMatcherModel {
+	return ProjectIntegrationMatcherModel{
+		ID:            types.Int64Value(payload.ID),
+		ProjectID:     types.Int64Value(projectID),
+		IntegrationID: types.Int64Value(integrationID),
+		Name:          types.StringValue(payload.Name),
+		MatchType:     types.StringValue(payload.MatchType),
+		Method:        types.StringValue(payload.Method),
+		BodyDataType:  types.StringValue(payload.BodyDataType),
+		Key:           types.StringValue(payload.Key),
+		Value:         types.StringValue(payload.Value),
+	}
+}
+
+// listMatchers returns every matcher defined on an integration. The API has no
+// GET-by-id for matchers, so all lookups go through this list.
+func (r *projectIntegrationMatcherResource) listMatchers(projectID, integrationID int64) ([]*models.IntegrationMatcher, error) {
+	response, err := r.client.Integration.GetProjectProjectIDIntegrationsIntegrationIDMatchers(
+		&integration.GetProjectProjectIDIntegrationsIntegrationIDMatchersParams{
+			ProjectID:     projectID,
+			IntegrationID: integrationID,
+		}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// findMatcher looks up a matcher by ID. Returns nil (and no error) when the
+// matcher no longer exists.
+func (r *projectIntegrationMatcherResource) findMatcher(projectID, integrationID, matcherID int64) (*models.IntegrationMatcher, error) {
+	matchers, err := r.listMatchers(projectID, integrationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matchers {
+		if m.ID == matcherID {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *projectIntegrationMatcherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectIntegrationMatcherModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueInt64()
+	integrationID := plan.IntegrationID.ValueInt64()
+
+	// The create endpoint does not return the new matcher, so remember which
+	// matchers already exist and pick out the newcomer afterwards.
+	existing, err := r.listMatchers(projectID, integrationID)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Matchers", "Could not list project integration matchers", err)...)
+		return
+	}
+	known := make(map[int64]bool, len(existing))
+	for _, m := range existing {
+		known[m.ID] = true
+	}
+
+	_, err = r.client.Integration.PostProjectProjectIDIntegrationsIntegrationIDMatchers(
+		&integration.PostProjectProjectIDIntegrationsIntegrationIDMatchersParams{
+			ProjectID:          projectID,
+			IntegrationID:      integrationID,
+			IntegrationMatcher: convertProjectIntegrationMatcherModelToIntegrationMatcher(plan),
+		}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Integration Matcher", "Could not create project integration matcher", err)...)
+		return
+	}
+
+	matchers, err := r.listMatchers(projectID, integrationID)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Matchers", "Could not list project integration matchers after create", err)...)
+		return
+	}
+	var created *models.IntegrationMatcher
+	for _, m := range matchers {
+		if known[m.ID] || m.Name != plan.Name.ValueString() {
+			continue
+		}
+		if created == nil || m.ID > created.ID {
+			created = m
+		}
+	}
+	if created == nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SemaphoreUI Project Integration Matcher",
+			fmt.Sprintf("The matcher %q was created but could not be found on integration %d afterwards.", plan.Name.ValueString(), integrationID),
+		)
+		return
+	}
+
+	model := convertIntegrationMatcherResponseToProjectIntegrationMatcherModel(projectID, integrationID, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *projectIntegrationMatcherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectIntegrationMatcherModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matcher, err := r.findMatcher(state.ProjectID.ValueInt64(), state.IntegrationID.ValueInt64(), state.ID.ValueInt64())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Matcher", "Could not read project integration matcher", err)...)
+		return
+	}
+	if matcher == nil {
+		// Drift: matcher deleted out-of-band. Remove from state.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model := convertIntegrationMatcherResponseToProjectIntegrationMatcherModel(state.ProjectID.ValueInt64(), state.IntegrationID.ValueInt64(), matcher)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *projectIntegrationMatcherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectIntegrationMatcherModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Integration.PutProjectProjectIDIntegrationsIntegrationIDMatchersMatcherID(
+		&integration.PutProjectProjectIDIntegrationsIntegrationIDMatchersMatcherIDParams{
+			ProjectID:          plan.ProjectID.ValueInt64(),
+			IntegrationID:      plan.IntegrationID.ValueInt64(),
+			MatcherID:          plan.ID.ValueInt64(),
+			IntegrationMatcher: convertProjectIntegrationMatcherModelToIntegrationMatcherRequest(plan),
+		}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Integration Matcher", "Could not update project integration matcher", err)...)
+		return
+	}
+
+	matcher, err := r.findMatcher(plan.ProjectID.ValueInt64(), plan.IntegrationID.ValueInt64(), plan.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Matcher", "Could not read project integration matcher after update", err)...)
+		return
+	}
+	if matcher == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project Integration Matcher",
+			fmt.Sprintf("Project integration matcher %d was not found after update.", plan.ID.ValueInt64()),
+		)
+		return
+	}
+
+	model := convertIntegrationMatcherResponseToProjectIntegrationMatcherModel(plan.ProjectID.ValueInt64(), plan.IntegrationID.ValueInt64(), matcher)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *projectIntegrationMatcherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectIntegrationMatcherModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Integration.DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherID(
+		&integration.DeleteProjectProjectIDIntegrationsIntegrationIDMatchersMatcherIDParams{
+			ProjectID:     state.ProjectID.ValueInt64(),
+			IntegrationID: state.IntegrationID.ValueInt64(),
+			MatcherID:     state.ID.ValueInt64(),
+		}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project Integration Matcher", "Could not remove project integration matcher", err)...)
+		return
+	}
+}
+
+func (r *projectIntegrationMatcherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "integration", "matcher"})
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Integration Matcher Import ID", "Could not parse import ID", err)...)
+		return
+	}
+
+	matcher, err := r.findMatcher(fields["project"], fields["integration"], fields["matcher"])
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration Matcher", "Could not read project integration matcher during import", err)...)
+		return
+	}
+	if matcher == nil {
+		resp.Diagnostics.AddError(
+			"Project Integration Matcher Not Found",
+			fmt.Sprintf("No matcher with id=%d found on integration %d.", fields["matcher"], fields["integration"]),
+		)
+		return
+	}
+
+	model := convertIntegrationMatcherResponseToProjectIntegrationMatcherModel(fields["project"], fields["integration"], matcher)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
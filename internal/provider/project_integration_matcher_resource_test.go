@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccProjectIntegrationMatcherExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.Attributes["id"] == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		id, _ := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		projectID, _ := strconv.ParseInt(rs.Primary.Attributes["project_id"], 10, 64)
+		integrationID, _ := strconv.ParseInt(rs.Primary.Attributes["integration_id"], 10, 64)
+
+		response, err := testClient().Integration.GetProjectProjectIDIntegrationsIntegrationIDMatchers(
+			&integration.GetProjectProjectIDIntegrationsIntegrationIDMatchersParams{
+				ProjectID:     projectID,
+				IntegrationID: integrationID,
+			}, nil)
+		if err != nil {
+			return fmt.Errorf("could not list integration matchers: %s", err.Error())
+		}
+		for _, m := range response.Payload {
+			if m.ID != id {
+				continue
+			}
+			if m.Value != rs.Primary.Attributes["value"] {
+				return fmt.Errorf("matcher value mismatch: %s != %s", m.Value, rs.Primary.Attributes["value"])
+			}
+			return nil
+		}
+		return fmt.Errorf("integration matcher %d not found under integration %d", id, integrationID)
+	}
+}
+
+func testAccProjectIntegrationMatcherConfig(nameSuffix string, body string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_integration_matcher" "test" {
+  project_id     = semaphoreui_project.test.id
+  integration_id = semaphoreui_project_integration.test.id
+  name           = "Matcher-%[2]s"
+  %[3]s
+}
+`, testAccProjectIntegrationConfig(nameSuffix, ""), nameSuffix, body)
+}
+
+func testAccProjectIntegrationMatcherImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("project/%[1]s/integration/%[2]s/matcher/%[3]s",
+			rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["integration_id"],
+			rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestAcc_ProjectIntegrationMatcherResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a body matcher with defaults.
+			{
+				Config: testAccProjectIntegrationMatcherConfig(nameSuffix, `
+  match_type = "body"
+  key        = "ref"
+  value      = "refs/heads/main"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationMatcherExists("semaphoreui_project_integration_matcher.test"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_integration_matcher.test", "id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "name", fmt.Sprintf("Matcher-%s", nameSuffix)),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "match_type", "body"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "method", "equals"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "body_data_type", "json"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "key", "ref"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "value", "refs/heads/main"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "semaphoreui_project_integration_matcher.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccProjectIntegrationMatcherImportID("semaphoreui_project_integration_matcher.test"),
+			},
+			// Update to a header matcher.
+			{
+				Config: testAccProjectIntegrationMatcherConfig(nameSuffix, `
+  match_type = "header"
+  method     = "contains"
+  key        = "X-GitHub-Event"
+  value      = "push"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectIntegrationMatcherExists("semaphoreui_project_integration_matcher.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "match_type", "header"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "method", "contains"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "key", "X-GitHub-Event"),
+					resource.TestCheckResourceAttr("semaphoreui_project_integration_matcher.test", "value", "push"),
+				),
+			},
+			// Delete
+			{
+				Config: testAccProjectIntegrationConfig(nameSuffix, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceNotExists("semaphoreui_project_integration_matcher.test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+type ProjectIntegrationMatcherModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ProjectID     types.Int64  `tfsdk:"project_id"`
+	IntegrationID types.Int64  `tfsdk:"integration_id"`
+	Name          types.String `tfsdk:"name"`
+	MatchType     types.String `tfsdk:"match_type"`
+	Method        types.String `tfsdk:"method"`
+	BodyDataType  types.String `tfsdk:"body_data_type"`
+	Key           types.String `tfsdk:"key"`
+	Value         types.String `tfsdk:"value"`
+}
+
+func ProjectIntegrationMatcherSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project integration matcher",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to manage a matcher rule on a project integration. An integration with matchers only runs its template when every matcher accepts the incoming webhook request.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The matcher ID.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+			"project_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The project ID that the integration belongs to.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"integration_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The integration ID that the matcher belongs to.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The display name of the matcher.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+			},
+			"match_type": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Which part of the incoming request to inspect: the request `body` or a request `header`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							models.IntegrationMatcherMatchTypeBody,
+							models.IntegrationMatcherMatchTypeHeader,
+						),
+					},
+				},
+			},
+			"method": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "How the extracted value is compared against `value`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(models.IntegrationMatcherMethodEquals),
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							models.IntegrationMatcherMethodEquals,
+							models.IntegrationMatcherMethodUnequals,
+							models.IntegrationMatcherMethodContains,
+						),
+					},
+				},
+			},
+			"body_data_type": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "How the request body is parsed to look up `key`. Only relevant when `match_type` is `body`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(models.IntegrationMatcherBodyDataTypeJSON),
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							models.IntegrationMatcherBodyDataTypeJSON,
+							models.IntegrationMatcherBodyDataTypeXML,
+							models.IntegrationMatcherBodyDataTypeString,
+						),
+					},
+				},
+			},
+			"key": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The header name, or the path into the request body (e.g. `ref` or `repository.full_name`), whose value is matched.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+			},
+			"value": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The value to compare against (e.g. `refs/heads/main`).",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
@@ -27,15 +27,15 @@ func (r *projectIntegrationResource) Configure(_ context.Context, req resource.C
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,10 +89,7 @@ func (r *projectIntegrationResource) Create(ctx context.Context, req resource.Cr
 		Integration: convertProjectIntegrationModelToIntegrationRequest(ctx, plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Integration",
-			"Could not create project integration, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Integration", "Could not create project integration", err)...)
 		return
 	}
 	model := convertIntegrationResponseToProjectIntegrationModel(ctx, response.Payload)
@@ -111,10 +108,12 @@ func (r *projectIntegrationResource) Read(ctx context.Context, req resource.Read
 		IntegrationID: state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Integration",
-			"Could not read project integration, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project integration deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration", "Could not read project integration", err)...)
 		return
 	}
 	model := convertIntegrationResponseToProjectIntegrationModel(ctx, response.Payload)
@@ -134,10 +133,7 @@ func (r *projectIntegrationResource) Update(ctx context.Context, req resource.Up
 		Integration:   convertProjectIntegrationModelToIntegrationRequest(ctx, plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Integration",
-			"Could not update project integration, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Integration", "Could not update project integration", err)...)
 		return
 	}
 
@@ -146,10 +142,7 @@ func (r *projectIntegrationResource) Update(ctx context.Context, req resource.Up
 		IntegrationID: plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Integration",
-			"Could not read project integration after update, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration", "Could not read project integration after update", err)...)
 		return
 	}
 	model := convertIntegrationResponseToProjectIntegrationModel(ctx, response.Payload)
@@ -167,22 +160,16 @@ func (r *projectIntegrationResource) Delete(ctx context.Context, req resource.De
 		ProjectID:     state.ProjectID.ValueInt64(),
 		IntegrationID: state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Project Integration",
-			"Could not remove project integration, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project Integration", "Could not remove project integration", err)...)
 		return
 	}
 }
 
 func (r *projectIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "integration"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "integration"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Integration Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Integration Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -191,10 +178,7 @@ func (r *projectIntegrationResource) ImportState(ctx context.Context, req resour
 		IntegrationID: fields["integration"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Integration",
-			"Could not read project integration, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integration", "Could not read project integration", err)...)
 		return
 	}
 	model := convertIntegrationResponseToProjectIntegrationModel(ctx, response.Payload)
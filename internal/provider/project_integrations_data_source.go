@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/integration"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectIntegrationsDataSource{}
+)
+
+func NewProjectIntegrationsDataSource() datasource.DataSource {
+	return &projectIntegrationsDataSource{}
+}
+
+type projectIntegrationsDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectIntegrationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectIntegrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_integrations"
+}
+
+type projectIntegrationsDataSourceModel struct {
+	ProjectID    types.Int64               `tfsdk:"project_id"`
+	NameRegex    types.String              `tfsdk:"name_regex"`
+	Integrations []ProjectIntegrationModel `tfsdk:"integrations"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectIntegrationsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	integrationAttributes := readOnlyAttributes(ProjectIntegrationSchema().GetDataSource(ctx).Attributes)
+	integrationAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The integration ID.",
+		Computed:            true,
+	}
+	integrationAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The display name of the integration.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Integrations of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("integrations"),
+			"name_regex": nameRegexAttribute("integrations", "name"),
+			"integrations": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the integrations that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: integrationAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectIntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectIntegrationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Integration.GetProjectProjectIDIntegrations(&integration.GetProjectProjectIDIntegrationsParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Integrations", "Could not read project integrations", err)...)
+		return
+	}
+
+	config.Integrations = []ProjectIntegrationModel{}
+	for _, integ := range response.Payload {
+		if !matchesName(integ.Name) {
+			continue
+		}
+		config.Integrations = append(config.Integrations, convertIntegrationResponseToProjectIntegrationModel(ctx, integ))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
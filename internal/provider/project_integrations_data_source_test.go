@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectIntegrationsDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Integrations DS Project"
+}
+
+resource "semaphoreui_project_key" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Repo"
+  url        = "/path/to/repo"
+  branch     = ""
+  ssh_key_id = semaphoreui_project_key.test.id
+}
+
+resource "semaphoreui_project_inventory" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Inventory"
+  ssh_key_id = semaphoreui_project_key.test.id
+  file = {
+    path          = "path/to/inventory"
+    repository_id = semaphoreui_project_repository.test.id
+  }
+}
+
+resource "semaphoreui_project_environment" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Env"
+}
+
+resource "semaphoreui_project_template" "test" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.test.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Template"
+  playbook       = "playbook.yml"
+}
+
+resource "semaphoreui_project_integration" "github" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.test.id
+  name        = "github-webhook"
+  auth_method = "github"
+  auth_header = "X-Hub-Signature-256"
+}
+
+resource "semaphoreui_project_integration" "gitlab" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.test.id
+  name        = "gitlab-webhook"
+}
+
+data "semaphoreui_project_integrations" "test" {
+  project_id = semaphoreui_project.test.id
+  name_regex = "^github-"
+  depends_on = [semaphoreui_project_integration.github, semaphoreui_project_integration.gitlab]
+}`
+}
+
+func TestAcc_ProjectIntegrationsDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectIntegrationsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_integrations.test", "integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_integrations.test", "integrations.0.name", "github-webhook"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_integrations.test", "integrations.0.auth_method", "github"),
+				),
+			},
+		},
+	})
+}
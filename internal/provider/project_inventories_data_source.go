@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/inventory"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectInventoriesDataSource{}
+)
+
+func NewProjectInventoriesDataSource() datasource.DataSource {
+	return &projectInventoriesDataSource{}
+}
+
+type projectInventoriesDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectInventoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectInventoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_inventories"
+}
+
+type projectInventoriesDataSourceModel struct {
+	ProjectID   types.Int64             `tfsdk:"project_id"`
+	NameRegex   types.String            `tfsdk:"name_regex"`
+	Type        types.String            `tfsdk:"type"`
+	Inventories []ProjectInventoryModel `tfsdk:"inventories"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectInventoriesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	inventoryAttributes := readOnlyAttributes(ProjectInventorySchema().GetDataSource(ctx).Attributes)
+	inventoryAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The inventory ID.",
+		Computed:            true,
+	}
+	inventoryAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The display name of the inventory or workspace.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Inventories of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("inventories"),
+			"name_regex": nameRegexAttribute("inventories", "name"),
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the inventories of this type: `static`, `static-yaml`, `file`, `terraform-workspace` or `tofu-workspace`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ProjectInventoryStatic, ProjectInventoryStaticYaml, ProjectInventoryFile, ProjectInventoryTerraformWorkspace, ProjectInventoryTofuWorkspace),
+				},
+			},
+			"inventories": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the inventories that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: inventoryAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectInventoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectInventoriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Inventory.GetProjectProjectIDInventory(&inventory.GetProjectProjectIDInventoryParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Inventories", "Could not read project inventories", err)...)
+		return
+	}
+
+	config.Inventories = []ProjectInventoryModel{}
+	for _, inventory := range response.Payload {
+		if !matchesName(inventory.Name) || (!config.Type.IsNull() && inventory.Type != config.Type.ValueString()) {
+			continue
+		}
+		config.Inventories = append(config.Inventories, convertInventoryResponseToProjectInventoryModel(inventory))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectInventoriesDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Inventories DS Project"
+}
+
+resource "semaphoreui_project_key" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_inventory" "static" {
+  project_id = semaphoreui_project.test.id
+  name       = "Static"
+  ssh_key_id = semaphoreui_project_key.test.id
+  static = {
+    inventory = "[all]\nhostname"
+  }
+}
+
+resource "semaphoreui_project_inventory" "yaml" {
+  project_id = semaphoreui_project.test.id
+  name       = "YAML"
+  ssh_key_id = semaphoreui_project_key.test.id
+  static_yaml = {
+    inventory = "all: {}"
+  }
+}
+
+data "semaphoreui_project_inventories" "test" {
+  project_id = semaphoreui_project.test.id
+  type       = "static-yaml"
+  depends_on = [semaphoreui_project_inventory.static, semaphoreui_project_inventory.yaml]
+}`
+}
+
+func TestAcc_ProjectInventoriesDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectInventoriesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_inventories.test", "inventories.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_inventories.test", "inventories.0.name", "YAML"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_inventories.test", "inventories.0.static_yaml.inventory", "all: {}"),
+				),
+			},
+		},
+	})
+}
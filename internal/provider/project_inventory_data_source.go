@@ -27,15 +27,15 @@ func (d *projectInventoryDataSource) Configure(_ context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -53,7 +53,7 @@ func (d *projectInventoryDataSource) GetInventoryByName(projectID int64, name st
 		ProjectID: projectID,
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read project inventories: %s", err.Error())
+		return nil, fmt.Errorf("could not read project inventories: %w", err)
 	}
 	for _, inventory := range response.Payload {
 		if inventory.Name == name {
@@ -78,20 +78,14 @@ func (d *projectInventoryDataSource) Read(ctx context.Context, req datasource.Re
 			InventoryID: config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Inventory",
-				"Could not read project inventory, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Inventory", "Could not read project inventory", err)...)
 			return
 		}
 		model = convertInventoryResponseToProjectInventoryModel(response.Payload)
 	} else if !config.Name.IsUnknown() && !config.Name.IsNull() {
 		inventory, err := d.GetInventoryByName(config.ProjectID.ValueInt64(), config.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Semaphore Project Inventory",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Inventory", "", err)...)
 			return
 		}
 		model = *inventory
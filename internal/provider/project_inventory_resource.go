@@ -17,6 +17,7 @@ var (
 	_ resource.ResourceWithConfigure        = &projectInventoryResource{}
 	_ resource.ResourceWithImportState      = &projectInventoryResource{}
 	_ resource.ResourceWithConfigValidators = &projectInventoryResource{}
+	_ resource.ResourceWithModifyPlan       = &projectInventoryResource{}
 )
 
 func NewProjectInventoryResource() resource.Resource {
@@ -32,16 +33,16 @@ func (r *projectInventoryResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 // Metadata returns the resource type name.
@@ -66,6 +67,16 @@ func (r *projectInventoryResource) ConfigValidators(ctx context.Context) []resou
 	}
 }
 
+func (r *projectInventoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateProjectReferences(ctx, r.client, req, []projectReference{
+		{path: path.Root("ssh_key_id"), kind: "key"},
+		{path: path.Root("static").AtName("become_key_id"), kind: "key"},
+		{path: path.Root("static_yaml").AtName("become_key_id"), kind: "key"},
+		{path: path.Root("file").AtName("become_key_id"), kind: "key"},
+		{path: path.Root("file").AtName("repository_id"), kind: "repository"},
+	})...)
+}
+
 func convertProjectInventoryModelToInventoryRequest(inventory ProjectInventoryModel) *models.InventoryRequest {
 	model := models.InventoryRequest{
 		ProjectID: inventory.ProjectID.ValueInt64(),
@@ -166,10 +177,7 @@ func (r *projectInventoryResource) Create(ctx context.Context, req resource.Crea
 		Inventory: convertProjectInventoryModelToInventoryRequest(plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Inventory",
-			"Could not create project inventory, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Inventory", "Could not create project inventory", err)...)
 		return
 	}
 	plan = convertInventoryResponseToProjectInventoryModel(response.Payload)
@@ -197,10 +205,12 @@ func (r *projectInventoryResource) Read(ctx context.Context, req resource.ReadRe
 		InventoryID: state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Inventory",
-			"Could not read project inventory, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project inventory deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Inventory", "Could not read project inventory", err)...)
 		return
 	}
 	state = convertInventoryResponseToProjectInventoryModel(response.Payload)
@@ -229,10 +239,7 @@ func (r *projectInventoryResource) Update(ctx context.Context, req resource.Upda
 		Inventory:   convertProjectInventoryModelToInventoryRequest(plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Inventory",
-			"Could not update project inventory, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Inventory", "Could not update project inventory", err)...)
 		return
 	}
 
@@ -242,10 +249,7 @@ func (r *projectInventoryResource) Update(ctx context.Context, req resource.Upda
 		InventoryID: plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Inventory",
-			"Could not read project inventory, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Inventory", "Could not read project inventory", err)...)
 		return
 	}
 	plan = convertInventoryResponseToProjectInventoryModel(response.Payload)
@@ -273,22 +277,16 @@ func (r *projectInventoryResource) Delete(ctx context.Context, req resource.Dele
 		ProjectID:   state.ProjectID.ValueInt64(),
 		InventoryID: state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting SemaphoreUI Project Inventory",
-			"Could not delete project inventory, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting SemaphoreUI Project Inventory", "Could not delete project inventory", err)...)
 		return
 	}
 }
 
 func (r *projectInventoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "inventory"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "inventory"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid ProjectInventory Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid ProjectInventory Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -297,10 +295,7 @@ func (r *projectInventoryResource) ImportState(ctx context.Context, req resource
 		InventoryID: fields["inventory"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Inventory",
-			"Could not read project inventory, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Inventory", "Could not read project inventory", err)...)
 		return
 	}
 	state := convertInventoryResponseToProjectInventoryModel(response.Payload)
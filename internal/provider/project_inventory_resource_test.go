@@ -457,3 +457,29 @@ func TestAcc_ProjectInventoryResource_updateType(t *testing.T) {
 		},
 	})
 }
+
+// TestAcc_ProjectInventoryResource_disappears verifies that a project inventory deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectInventoryResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectProjectInventoryFileConfig(nameSuffix, "path/to/inventory"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectInventoryExists("semaphoreui_project_inventory.test", ProjectInventoryFile),
+					testAccDeleteOutOfBand("semaphoreui_project_inventory.test", func(attributes map[string]string) error {
+						_, err := testClient().Inventory.DeleteProjectProjectIDInventoryInventoryID(&inventory.DeleteProjectProjectIDInventoryInventoryIDParams{
+							ProjectID:   testAccInt64Attribute(attributes, "project_id"),
+							InventoryID: testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+var (
+	_ resource.Resource                = &projectInviteResource{}
+	_ resource.ResourceWithConfigure   = &projectInviteResource{}
+	_ resource.ResourceWithImportState = &projectInviteResource{}
+)
+
+func NewProjectInviteResource() resource.Resource {
+	return &projectInviteResource{}
+}
+
+type projectInviteResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectInviteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *projectInviteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_invite"
+}
+
+func (r *projectInviteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectInviteSchema().GetResource(ctx)
+}
+
+// convertProjectInviteResponseToProjectInviteModel converts an invite
+// returned by the API. As for schedules, the expires_at of the configuration
+// or state, if given, is kept when it is the same time.
+func convertProjectInviteResponseToProjectInviteModel(invite *models.ProjectInvite, expiresAt types.String) ProjectInviteModel {
+	model := ProjectInviteModel{
+		ID:            types.Int64Value(invite.ID),
+		ProjectID:     types.Int64Value(invite.ProjectID),
+		Email:         types.StringValue(invite.Email.String()),
+		Role:          types.StringValue(invite.Role),
+		ExpiresAt:     types.StringValue(time.Time(invite.ExpiresAt).UTC().Format(time.RFC3339)),
+		Status:        types.StringValue(invite.Status),
+		Created:       types.StringValue(time.Time(invite.Created).UTC().Format(time.RFC3339)),
+		InviterUserID: types.Int64Value(invite.InviterUserID),
+	}
+	if previous, err := strfmt.ParseDateTime(expiresAt.ValueString()); err == nil && previous.Equal(invite.ExpiresAt) {
+		model.ExpiresAt = expiresAt
+	}
+	return model
+}
+
+func (r *projectInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectInviteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite := &models.ProjectInviteRequest{
+		Email: strfmt.Email(plan.Email.ValueString()),
+		Role:  plan.Role.ValueStringPointer(),
+	}
+	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() {
+		// The value was validated by the schema.
+		invite.ExpiresAt, _ = strfmt.ParseDateTime(plan.ExpiresAt.ValueString())
+	}
+	response, err := r.client.Project.PostProjectProjectIDInvites(&project.PostProjectProjectIDInvitesParams{
+		ProjectID: plan.ProjectID.ValueInt64(),
+		Invite:    invite,
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics(
+			"Error Creating SemaphoreUI Project Invite",
+			fmt.Sprintf("Could not invite %s to the project", plan.Email.ValueString()),
+			err,
+		)...)
+		return
+	}
+
+	model := convertProjectInviteResponseToProjectInviteModel(response.Payload, plan.ExpiresAt)
+	// The email is kept as configured, as the API may normalize it.
+	model.Email = plan.Email
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *projectInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectInviteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Project.GetProjectProjectIDInvitesInviteID(&project.GetProjectProjectIDInvitesInviteIDParams{
+		ProjectID: state.ProjectID.ValueInt64(),
+		InviteID:  state.ID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics(
+			"Error Reading SemaphoreUI Project Invite",
+			fmt.Sprintf("Could not read invite %d of project %d", state.ID.ValueInt64(), state.ProjectID.ValueInt64()),
+			err,
+		)...)
+		return
+	}
+
+	model := convertProjectInviteResponseToProjectInviteModel(response.Payload, state.ExpiresAt)
+	if !state.Email.IsNull() && response.Payload.Email.String() == state.Email.ValueString() {
+		model.Email = state.Email
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *projectInviteResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All settable attributes are RequiresReplace, so Update should never be
+	// called. Defensive error if it is.
+	resp.Diagnostics.AddError(
+		"Project Invite Update Not Supported",
+		"Project invites are immutable. Any change to project_id, email, role or expires_at forces resource replacement; Update should not be reachable.",
+	)
+}
+
+func (r *projectInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectInviteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Project.DeleteProjectProjectIDInvitesInviteID(&project.DeleteProjectProjectIDInvitesInviteIDParams{
+		ProjectID: state.ProjectID.ValueInt64(),
+		InviteID:  state.ID.ValueInt64(),
+	}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics(
+			"Error Deleting SemaphoreUI Project Invite",
+			fmt.Sprintf("Could not delete invite %d of project %d", state.ID.ValueInt64(), state.ProjectID.ValueInt64()),
+			err,
+		)...)
+	}
+}
+
+func (r *projectInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "invite"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Project Invite Import ID",
+			fmt.Sprintf("Could not parse import ID %q: %s. Expected `project/{id}/invite/{id}`, where the project may also be given by name and the invite by email.", req.ID, err.Error()),
+		)
+		return
+	}
+
+	response, err := r.client.Project.GetProjectProjectIDInvitesInviteID(&project.GetProjectProjectIDInvitesInviteIDParams{
+		ProjectID: fields["project"],
+		InviteID:  fields["invite"],
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics(
+			"Error Importing SemaphoreUI Project Invite",
+			fmt.Sprintf("Could not read invite %d of project %d", fields["invite"], fields["project"]),
+			err,
+		)...)
+		return
+	}
+
+	model := convertProjectInviteResponseToProjectInviteModel(response.Payload, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccProjectInviteConfig(nameSuffix string, role string, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%[1]s"
+}
+
+resource "semaphoreui_project_invite" "test" {
+  project_id = semaphoreui_project.test.id
+  email      = "contractor-%[1]s@example.com"
+  role       = %[2]q
+  expires_at = %[3]s
+}`, nameSuffix, role, expiresAt)
+}
+
+func testAccProjectInviteImportID(n string, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("project/%s/invite/%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes[attribute]), nil
+	}
+}
+
+func TestAcc_ProjectInviteResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectInviteConfig(nameSuffix, "guest", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("semaphoreui_project_invite.test", "id"),
+					resource.TestCheckResourceAttrPair("semaphoreui_project_invite.test", "project_id", "semaphoreui_project.test", "id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "email", fmt.Sprintf("contractor-%s@example.com", nameSuffix)),
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "role", "guest"),
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "status", "pending"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_invite.test", "expires_at"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_invite.test", "created"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_invite.test", "inviter_user_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "semaphoreui_project_invite.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccProjectInviteImportID("semaphoreui_project_invite.test", "id"),
+			},
+			{
+				ResourceName:      "semaphoreui_project_invite.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccProjectInviteImportID("semaphoreui_project_invite.test", "email"),
+			},
+			// Replacing the invite
+			{
+				Config: testAccProjectInviteConfig(nameSuffix, "manager", `"2030-01-31T22:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "role", "manager"),
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "expires_at", "2030-01-31T22:00:00Z"),
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+// TestAcc_ProjectInviteResource_drift verifies that an invite deleted
+// out-of-band is sent again, and that a declined invite is reported.
+func TestAcc_ProjectInviteResource_drift(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	config := testAccProjectInviteConfig(nameSuffix, "guest", "null")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						invite := s.RootModule().Resources["semaphoreui_project_invite.test"].Primary.Attributes
+						_, err := testClient().Project.DeleteProjectProjectIDInvitesInviteID(&project.DeleteProjectProjectIDInvitesInviteIDParams{
+							ProjectID: testAccInt64Attribute(invite, "project_id"),
+							InviteID:  testAccInt64Attribute(invite, "id"),
+						}, nil)
+						return err
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "status", "pending"),
+					func(s *terraform.State) error {
+						invite := s.RootModule().Resources["semaphoreui_project_invite.test"].Primary.Attributes
+						_, err := testClient().Project.PutProjectProjectIDInvitesInviteID(&project.PutProjectProjectIDInvitesInviteIDParams{
+							ProjectID: testAccInt64Attribute(invite, "project_id"),
+							InviteID:  testAccInt64Attribute(invite, "id"),
+							InviteUpdate: project.PutProjectProjectIDInvitesInviteIDBody{
+								Status: project.PutProjectProjectIDInvitesInviteIDBodyStatusDeclined,
+							},
+						}, nil)
+						return err
+					},
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_invite.test", "status", "declined"),
+				),
+			},
+		},
+	})
+}
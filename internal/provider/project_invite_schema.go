@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	semaphorevalidator "terraform-provider-semaphoreui/internal/stringvalidator"
+)
+
+type ProjectInviteModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ProjectID     types.Int64  `tfsdk:"project_id"`
+	Email         types.String `tfsdk:"email"`
+	Role          types.String `tfsdk:"role"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	Status        types.String `tfsdk:"status"`
+	Created       types.String `tfsdk:"created"`
+	InviterUserID types.Int64  `tfsdk:"inviter_user_id"`
+}
+
+func ProjectInviteSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project invite",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource invites a user to a project by email. The user joins the project with the given role when they accept the invitation. Invitations cannot be changed: changes to any attribute force a new invitation to be sent. Deleting the resource withdraws the invitation, but does not remove a user that already accepted it from the project.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The invite ID.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+			"project_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The project ID that the user is invited to.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"email": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The email address that the invitation is sent to.",
+					Required:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"role": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Role of the user in the project once they accept the invitation.",
+					Required:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+					Validators: []validator.String{
+						stringvalidator.OneOf("owner", "manager", "task_runner", "guest"),
+					},
+				},
+			},
+			"expires_at": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The time at which the invitation expires. SemaphoreUI defaults to 7 days after the invitation is created.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						semaphorevalidator.RFC3339(),
+					},
+				},
+			},
+			"status": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The status of the invitation: `pending`, `accepted`, `declined` or `expired`.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Computed: true,
+				},
+			},
+			"created": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Creation date of the invitation.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+			"inviter_user_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The ID of the user that created the invitation.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+		},
+	}
+}
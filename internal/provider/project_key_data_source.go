@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/key_store"
+	"terraform-provider-semaphoreui/semaphoreui/models"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 )
@@ -28,15 +29,15 @@ func (d *projectKeyDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -49,32 +50,39 @@ func (d *projectKeyDataSource) Schema(ctx context.Context, _ datasource.SchemaRe
 	resp.Schema = ProjectKeySchema().GetDataSource(ctx)
 }
 
+// convertAccessKeyToProjectKeyDataSourceModel converts a key of the list of
+// project keys. The API never returns secrets, so they are left empty.
+func convertAccessKeyToProjectKeyDataSourceModel(key *models.AccessKey) ProjectKeyModel {
+	model := ProjectKeyModel{
+		ProjectID: types.Int64Value(key.ProjectID),
+		ID:        types.Int64Value(key.ID),
+		Name:      types.StringValue(key.Name),
+	}
+	switch key.Type {
+	case ProjectKeyTypeNone:
+		model.None = &ProjectKeyNone{}
+	case ProjectKeyTypeLoginPassword:
+		model.LoginPassword = &ProjectKeyLoginPassword{
+			Password: types.StringValue(""),
+		}
+	case ProjectKeyTypeSSH:
+		model.SSH = &ProjectKeySSH{
+			PrivateKey: types.StringValue(""),
+		}
+	}
+	return model
+}
+
 func (d *projectKeyDataSource) GetKeyByName(projectID int64, name string) (*ProjectKeyModel, error) {
 	response, err := d.client.KeyStore.GetProjectProjectIDKeys(&key_store.GetProjectProjectIDKeysParams{
 		ProjectID: projectID,
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read project keys: %s", err.Error())
+		return nil, fmt.Errorf("could not read project keys: %w", err)
 	}
 	for _, key := range response.Payload {
 		if key.Name == name {
-			model := ProjectKeyModel{
-				ProjectID: types.Int64Value(key.ProjectID),
-				ID:        types.Int64Value(key.ID),
-				Name:      types.StringValue(key.Name),
-			}
-			switch key.Type {
-			case ProjectKeyTypeNone:
-				model.None = &ProjectKeyNone{}
-			case ProjectKeyTypeLoginPassword:
-				model.LoginPassword = &ProjectKeyLoginPassword{
-					Password: types.StringValue(""),
-				}
-			case ProjectKeyTypeSSH:
-				model.SSH = &ProjectKeySSH{
-					PrivateKey: types.StringValue(""),
-				}
-			}
+			model := convertAccessKeyToProjectKeyDataSourceModel(key)
 			return &model, nil
 		}
 	}
@@ -86,27 +94,11 @@ func (d *projectKeyDataSource) GetKeyByID(projectID int64, ID int64) (*ProjectKe
 		ProjectID: projectID,
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read project keys: %s", err.Error())
+		return nil, fmt.Errorf("could not read project keys: %w", err)
 	}
 	for _, key := range response.Payload {
 		if key.ID == ID {
-			model := ProjectKeyModel{
-				ProjectID: types.Int64Value(key.ProjectID),
-				ID:        types.Int64Value(key.ID),
-				Name:      types.StringValue(key.Name),
-			}
-			switch key.Type {
-			case ProjectKeyTypeNone:
-				model.None = &ProjectKeyNone{}
-			case ProjectKeyTypeLoginPassword:
-				model.LoginPassword = &ProjectKeyLoginPassword{
-					Password: types.StringValue(""),
-				}
-			case ProjectKeyTypeSSH:
-				model.SSH = &ProjectKeySSH{
-					PrivateKey: types.StringValue(""),
-				}
-			}
+			model := convertAccessKeyToProjectKeyDataSourceModel(key)
 			return &model, nil
 		}
 	}
@@ -124,20 +116,14 @@ func (d *projectKeyDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if !config.ID.IsUnknown() && !config.ID.IsNull() {
 		key, err := d.GetKeyByID(config.ProjectID.ValueInt64(), config.ID.ValueInt64())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Key",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Key", "", err)...)
 			return
 		}
 		model = *key
 	} else if !config.Name.IsUnknown() && !config.Name.IsNull() {
 		key, err := d.GetKeyByName(config.ProjectID.ValueInt64(), config.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Key",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Key", "", err)...)
 			return
 		}
 		model = *key
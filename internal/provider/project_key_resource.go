@@ -33,16 +33,16 @@ func (r *projectKeyResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -166,7 +166,7 @@ func (r *projectKeyResource) getProjectKeyModelFromClient(projectId types.Int64,
 		ProjectID: projectId.ValueInt64(),
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Keys for project ID %d: %s", projectId.ValueInt64(), err.Error())
+		return nil, fmt.Errorf("could not read Keys for project ID %d: %w", projectId.ValueInt64(), err)
 	}
 
 	for _, key := range payload.Payload {
@@ -187,7 +187,7 @@ func (r *projectKeyResource) getProjectKeyModelFromClient(projectId types.Int64,
 			return &model, nil
 		}
 	}
-	return nil, fmt.Errorf("key with ID %d not found in project with ID %d", keyId.ValueInt64(), projectId.ValueInt64())
+	return nil, fmt.Errorf("key with ID %d %w in project with ID %d", keyId.ValueInt64(), errNotFound, projectId.ValueInt64())
 }
 
 func (r *projectKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -206,10 +206,7 @@ func (r *projectKeyResource) Create(ctx context.Context, req resource.CreateRequ
 		AccessKey: convertProjectKeyModelToAccessKeyRequest(plan, secrets),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Key",
-			"Could not create project key, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Key", "Could not create project key", err)...)
 		return
 	}
 	plan = convertAccessKeyResponseToProjectKeyModel(response.Payload, &plan)
@@ -230,10 +227,12 @@ func (r *projectKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	model, err := r.getProjectKeyModelFromClient(state.ProjectID, state.ID, &state)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project Keys",
-			err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project key deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Keys", "", err)...)
 		return
 	}
 
@@ -321,20 +320,14 @@ func (r *projectKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 		AccessKey: key,
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Key",
-			"Could not update project key, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Key", "Could not update project key", err)...)
 		return
 	}
 
 	// Fetch updated values as PutProjectProjectIDKeysKeyID does not return updated projectKey
 	model, err := r.getProjectKeyModelFromClient(state.ProjectID, state.ID, &plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project Keys",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Keys", "", err)...)
 		return
 	}
 
@@ -359,22 +352,16 @@ func (r *projectKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		ProjectID: state.ProjectID.ValueInt64(),
 		KeyID:     state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Semaphore Project Key",
-			fmt.Sprintf("Could not delete project key, unexpected error: %s", err.Error()),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting Semaphore Project Key", "Could not delete project key", err)...)
 		return
 	}
 }
 
 func (r *projectKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "key"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "key"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Key Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Key Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -389,10 +376,7 @@ func (r *projectKeyResource) ImportState(ctx context.Context, req resource.Impor
 		None: &ProjectKeyNone{},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project Keys",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Keys", "", err)...)
 		return
 	}
 
@@ -442,3 +442,29 @@ func TestAcc_ProjectKeyResource_writeOnlyMutexRejected(t *testing.T) {
 		},
 	})
 }
+
+// TestAcc_ProjectKeyResource_disappears verifies that a project key deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectKeyResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectKeyNoneConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectKeyExists("semaphoreui_project_key.test", ProjectKeyTypeNone),
+					testAccDeleteOutOfBand("semaphoreui_project_key.test", func(attributes map[string]string) error {
+						_, err := testClient().KeyStore.DeleteProjectProjectIDKeysKeyID(&key_store.DeleteProjectProjectIDKeysKeyIDParams{
+							ProjectID: testAccInt64Attribute(attributes, "project_id"),
+							KeyID:     testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/key_store"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectKeysDataSource{}
+)
+
+func NewProjectKeysDataSource() datasource.DataSource {
+	return &projectKeysDataSource{}
+}
+
+type projectKeysDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_keys"
+}
+
+type projectKeysDataSourceModel struct {
+	ProjectID types.Int64       `tfsdk:"project_id"`
+	NameRegex types.String      `tfsdk:"name_regex"`
+	Type      types.String      `tfsdk:"type"`
+	Keys      []ProjectKeyModel `tfsdk:"keys"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectKeysDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	keyAttributes := readOnlyAttributes(ProjectKeySchema().GetDataSource(ctx).Attributes)
+	keyAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The key ID.",
+		Computed:            true,
+	}
+	keyAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The display name of the key.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Keys of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("keys"),
+			"name_regex": nameRegexAttribute("keys", "name"),
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the keys of this type: `none`, `login_password` or `ssh`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ProjectKeyTypeNone, ProjectKeyTypeLoginPassword, ProjectKeyTypeSSH),
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the keys that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: keyAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.KeyStore.GetProjectProjectIDKeys(&key_store.GetProjectProjectIDKeysParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Keys", "Could not read project keys", err)...)
+		return
+	}
+
+	config.Keys = []ProjectKeyModel{}
+	for _, key := range response.Payload {
+		if !matchesName(key.Name) || (!config.Type.IsNull() && key.Type != config.Type.ValueString()) {
+			continue
+		}
+		config.Keys = append(config.Keys, convertAccessKeyToProjectKeyDataSourceModel(key))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
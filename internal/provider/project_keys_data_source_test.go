@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectKeysDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Keys DS Project"
+}
+
+resource "semaphoreui_project_key" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_key" "deploy" {
+  project_id = semaphoreui_project.test.id
+  name       = "Deploy"
+  login_password = {
+    login    = "deploy"
+    password = "secret"
+  }
+}
+
+resource "semaphoreui_project_key" "git" {
+  project_id = semaphoreui_project.test.id
+  name       = "Git"
+  login_password = {
+    login    = "git"
+    password = "secret"
+  }
+}
+
+data "semaphoreui_project_keys" "all" {
+  project_id = semaphoreui_project.test.id
+  depends_on = [semaphoreui_project_key.test, semaphoreui_project_key.deploy, semaphoreui_project_key.git]
+}
+
+data "semaphoreui_project_keys" "test" {
+  project_id = semaphoreui_project.test.id
+  name_regex = "^D"
+  type       = "login_password"
+  depends_on = [semaphoreui_project_key.test, semaphoreui_project_key.deploy, semaphoreui_project_key.git]
+}`
+}
+
+func TestAcc_ProjectKeysDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectKeysDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_keys.all", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_keys.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_keys.test", "keys.0.name", "Deploy"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_keys.test", "keys.0.login_password.login", "deploy"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_keys.test", "keys.0.id", "semaphoreui_project_key.deploy", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,462 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/user"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectMembersResource{}
+	_ resource.ResourceWithConfigure   = &projectMembersResource{}
+	_ resource.ResourceWithImportState = &projectMembersResource{}
+	_ resource.ResourceWithModifyPlan  = &projectMembersResource{}
+)
+
+func NewProjectMembersResource() resource.Resource {
+	return &projectMembersResource{}
+}
+
+type projectMembersResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *projectMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_members"
+}
+
+func (r *projectMembersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectMembersSchema().GetResource(ctx)
+}
+
+// projectMembers maps the IDs of the members of a project to their roles.
+type projectMembers map[int64]string
+
+func (r *projectMembersResource) getProjectMembersFromAPI(projectID int64) (projectMembers, error) {
+	payload, err := r.client.Project.GetProjectProjectIDUsers(&project.GetProjectProjectIDUsersParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Users for project ID %d: %w", projectID, err)
+	}
+
+	members := make(projectMembers, len(payload.Payload))
+	for _, member := range payload.Payload {
+		members[member.ID] = member.Role
+	}
+	return members, nil
+}
+
+// currentUserID returns the ID of the user of the provider credentials.
+func (r *projectMembersResource) currentUserID() (int64, error) {
+	payload, err := r.client.User.GetUser(&user.GetUserParams{}, nil)
+	if err != nil {
+		return 0, fmt.Errorf("could not read the user of the provider credentials: %w", err)
+	}
+	return payload.Payload.ID, nil
+}
+
+func convertProjectMembersToModel(projectID int64, members projectMembers) ProjectMembersModel {
+	elements := make([]attr.Value, 0, len(members))
+	for _, userID := range slices.Sorted(maps.Keys(members)) {
+		elements = append(elements, types.ObjectValueMust(projectMemberAttrTypes, map[string]attr.Value{
+			"user_id": types.Int64Value(userID),
+			"role":    types.StringValue(members[userID]),
+		}))
+	}
+	return ProjectMembersModel{
+		ProjectID: types.Int64Value(projectID),
+		Members:   types.SetValueMust(types.ObjectType{AttrTypes: projectMemberAttrTypes}, elements),
+	}
+}
+
+// convertProjectMembersModelToMembers returns the members of a model. known
+// is false when the members are not known yet, e.g. when the ID of a user is
+// only known once the user is created.
+func convertProjectMembersModelToMembers(ctx context.Context, model ProjectMembersModel) (members projectMembers, known bool, diags diag.Diagnostics) {
+	if model.Members.IsNull() || model.Members.IsUnknown() {
+		return nil, false, diags
+	}
+	for _, element := range model.Members.Elements() {
+		if element.IsUnknown() {
+			return nil, false, diags
+		}
+	}
+
+	var elements []ProjectMemberModel
+	diags.Append(model.Members.ElementsAs(ctx, &elements, false)...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	members = make(projectMembers, len(elements))
+	for _, element := range elements {
+		if element.UserID.IsUnknown() || element.Role.IsUnknown() {
+			return nil, false, diags
+		}
+		userID := element.UserID.ValueInt64()
+		if _, ok := members[userID]; ok {
+			diags.AddAttributeError(
+				path.Root("members"),
+				"Duplicate Project Member",
+				fmt.Sprintf("User ID %d is listed more than once. A user has a single role in a project.", userID),
+			)
+			return nil, false, diags
+		}
+		members[userID] = element.Role.ValueString()
+	}
+	return members, true, diags
+}
+
+// projectMemberChanges are the users to add to a project, whose role to
+// update, and to remove from the project, in the order the requests are made.
+type projectMemberChanges struct {
+	add    []int64
+	update []int64
+	remove []int64
+}
+
+// planProjectMemberChanges returns the changes that turn the current members
+// of a project into the planned ones. It refuses to leave the project without
+// an owner, or to remove selfID, the user of the provider credentials, from
+// it. Users are added and updated before others are removed, so that the
+// project keeps an owner throughout, and the role of selfID is updated last
+// so that the provider keeps its permissions until then.
+func planProjectMemberChanges(current, planned projectMembers, selfID int64) (projectMemberChanges, error) {
+	var changes projectMemberChanges
+
+	if !slices.Contains(slices.Collect(maps.Values(planned)), "owner") {
+		return changes, errors.New("at least one member must be an owner, so that the project can still be managed")
+	}
+	if _, ok := current[selfID]; ok {
+		if _, ok := planned[selfID]; !ok {
+			return changes, fmt.Errorf("user ID %d is the user of the provider credentials and cannot be removed from the project, add it to the members", selfID)
+		}
+	}
+
+	for _, userID := range slices.Sorted(maps.Keys(planned)) {
+		role, ok := current[userID]
+		switch {
+		case !ok:
+			changes.add = append(changes.add, userID)
+		case role != planned[userID] && userID != selfID:
+			changes.update = append(changes.update, userID)
+		}
+	}
+	if role, ok := current[selfID]; ok && role != planned[selfID] {
+		changes.update = append(changes.update, selfID)
+	}
+	for _, userID := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := planned[userID]; !ok {
+			changes.remove = append(changes.remove, userID)
+		}
+	}
+	return changes, nil
+}
+
+// releaseProjectMembers returns the members to remove from a project when
+// the resource is destroyed: all of them but the user of the provider
+// credentials and, unless that user is an owner, one owner.
+func releaseProjectMembers(current projectMembers, selfID int64) []int64 {
+	var remove []int64
+	keepOwner := current[selfID] != "owner"
+	for _, userID := range slices.Sorted(maps.Keys(current)) {
+		switch {
+		case userID == selfID:
+		case keepOwner && current[userID] == "owner":
+			keepOwner = false
+		default:
+			remove = append(remove, userID)
+		}
+	}
+	return remove
+}
+
+func (r *projectMembersResource) addProjectMember(projectID, userID int64, role string) error {
+	_, err := r.client.Project.PostProjectProjectIDUsers(&project.PostProjectProjectIDUsersParams{
+		ProjectID: projectID,
+		User: project.PostProjectProjectIDUsersBody{
+			UserID: userID,
+			Role:   role,
+		},
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("could not add user ID %d to project ID %d: %w", userID, projectID, err)
+	}
+	return nil
+}
+
+func (r *projectMembersResource) updateProjectMember(projectID, userID int64, role string) error {
+	_, err := r.client.Project.PutProjectProjectIDUsersUserID(&project.PutProjectProjectIDUsersUserIDParams{
+		ProjectID: projectID,
+		UserID:    userID,
+		ProjectUser: project.PutProjectProjectIDUsersUserIDBody{
+			Role: role,
+		},
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("could not update the role of user ID %d in project ID %d: %w", userID, projectID, err)
+	}
+	return nil
+}
+
+func (r *projectMembersResource) removeProjectMember(projectID, userID int64) error {
+	_, err := r.client.Project.DeleteProjectProjectIDUsersUserID(&project.DeleteProjectProjectIDUsersUserIDParams{
+		ProjectID: projectID,
+		UserID:    userID,
+	}, nil)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("could not remove user ID %d from project ID %d: %w", userID, projectID, err)
+	}
+	return nil
+}
+
+// setProjectMembers converges the members of a project to the planned ones.
+func (r *projectMembersResource) setProjectMembers(projectID int64, planned projectMembers) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.getProjectMembersFromAPI(projectID)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
+		return diags
+	}
+	selfID, err := r.currentUserID()
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Reading Semaphore User", "", err)...)
+		return diags
+	}
+	changes, err := planProjectMemberChanges(current, planned, selfID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("members"), "Invalid Project Members", fmt.Sprintf("Cannot set the members of the project: %s.", err))
+		return diags
+	}
+
+	for _, userID := range changes.add {
+		if err := r.addProjectMember(projectID, userID, planned[userID]); err != nil {
+			diags.Append(apiErrorDiagnostics("Error Adding Semaphore Project User", "", err)...)
+			return diags
+		}
+	}
+	for _, userID := range changes.update {
+		if err := r.updateProjectMember(projectID, userID, planned[userID]); err != nil {
+			diags.Append(apiErrorDiagnostics("Error Updating Semaphore Project User", "", err)...)
+			return diags
+		}
+	}
+	for _, userID := range changes.remove {
+		if err := r.removeProjectMember(projectID, userID); err != nil {
+			diags.Append(apiErrorDiagnostics("Error Removing Semaphore Project User", "", err)...)
+			return diags
+		}
+	}
+	return diags
+}
+
+// ModifyPlan checks the planned members against the current ones, so that
+// removing the user of the provider credentials is reported at plan time.
+// When the project does not exist yet or its members cannot be read, only
+// the owner is checked and the rest is left to apply.
+func (r *projectMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectMembersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planned, known, diags := convertProjectMembersModelToMembers(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if !known {
+		return
+	}
+
+	var current projectMembers
+	var selfID int64
+	if r.client != nil && !plan.ProjectID.IsUnknown() {
+		if members, err := r.getProjectMembersFromAPI(plan.ProjectID.ValueInt64()); err == nil {
+			if id, err := r.currentUserID(); err == nil {
+				current, selfID = members, id
+			}
+		}
+	}
+	if _, err := planProjectMemberChanges(current, planned, selfID); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("members"), "Invalid Project Members", fmt.Sprintf("Cannot set the members of the project: %s.", err))
+	}
+}
+
+func (r *projectMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ProjectMembersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planned, _, diags := convertProjectMembersModelToMembers(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setProjectMembers(plan.ProjectID.ValueInt64(), planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch updated values as the requests do not return the members
+	members, err := r.getProjectMembersFromAPI(plan.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
+		return
+	}
+
+	// Set state to fully populated data
+	state := convertProjectMembersToModel(plan.ProjectID.ValueInt64(), members)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ProjectMembersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from API
+	members, err := r.getProjectMembersFromAPI(state.ProjectID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			// Drift: project deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
+		return
+	}
+
+	// Set refreshed state
+	state = convertProjectMembersToModel(state.ProjectID.ValueInt64(), members)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ProjectMembersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planned, _, diags := convertProjectMembersModelToMembers(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setProjectMembers(plan.ProjectID.ValueInt64(), planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch updated values as the requests do not return the members
+	members, err := r.getProjectMembersFromAPI(plan.ProjectID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
+		return
+	}
+
+	// Update resource state with updated members
+	state := convertProjectMembersToModel(plan.ProjectID.ValueInt64(), members)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the members of the project, except the user of the provider
+// credentials and an owner, so that the project can still be managed.
+func (r *projectMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state ProjectMembersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.getProjectMembersFromAPI(state.ProjectID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
+		return
+	}
+	selfID, err := r.currentUserID()
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore User", "", err)...)
+		return
+	}
+
+	for _, userID := range releaseProjectMembers(members, selfID) {
+		if err := r.removeProjectMember(state.ProjectID.ValueInt64(), userID); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing Semaphore Project User", "", err)...)
+			return
+		}
+	}
+}
+
+func (r *projectMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(r.client, req.ID, []string{"project"})
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Members Import ID", "Could not parse import ID", err)...)
+		return
+	}
+
+	members, err := r.getProjectMembersFromAPI(fields["project"])
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Importing Semaphore Project Members", "", err)...)
+		return
+	}
+
+	state := convertProjectMembersToModel(fields["project"], members)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccProjectMembersConfig(nameSuffix string, members string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%[1]s"
+}
+
+data "semaphoreui_user" "admin" {
+  username = "admin"
+}
+
+resource "semaphoreui_user" "test" {
+  username = "test-%[1]s"
+  name     = "test-%[1]s"
+  email    = "test-%[1]s@example.com"
+}
+
+resource "semaphoreui_user" "other" {
+  username = "other-%[1]s"
+  name     = "other-%[1]s"
+  email    = "other-%[1]s@example.com"
+}
+
+resource "semaphoreui_project_members" "test" {
+  project_id = semaphoreui_project.test.id
+  members    = [%[2]s]
+}`, nameSuffix, members)
+}
+
+func testAccProjectMembersImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("project/%s", rs.Primary.Attributes["project_id"]), nil
+	}
+}
+
+func TestAcc_ProjectMembersResource(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectMembersConfig(nameSuffix, `
+    { user_id = data.semaphoreui_user.admin.id, role = "owner" },
+    { user_id = semaphoreui_user.test.id, role = "guest" },
+  `),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("semaphoreui_project_members.test", "project_id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_members.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_members.test", "members.*", map[string]string{"role": "owner"}),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_members.test", "members.*", map[string]string{"role": "guest"}),
+					resource.TestCheckTypeSetElemAttrPair("semaphoreui_project_members.test", "members.*.user_id", "semaphoreui_user.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "semaphoreui_project_members.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccProjectMembersImportID("semaphoreui_project_members.test"),
+				ImportStateVerifyIdentifierAttribute: "project_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectMembersConfig(nameSuffix, `
+    { user_id = data.semaphoreui_user.admin.id, role = "owner" },
+    { user_id = semaphoreui_user.test.id, role = "manager" },
+    { user_id = semaphoreui_user.other.id, role = "task_runner" },
+  `),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_members.test", "members.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_members.test", "members.*", map[string]string{"role": "manager"}),
+					resource.TestCheckTypeSetElemNestedAttrs("semaphoreui_project_members.test", "members.*", map[string]string{"role": "task_runner"}),
+				),
+			},
+			// Removing members
+			{
+				Config: testAccProjectMembersConfig(nameSuffix, `
+    { user_id = data.semaphoreui_user.admin.id, role = "owner" },
+  `),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_members.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("semaphoreui_project_members.test", "members.*.user_id", "data.semaphoreui_user.admin", "id"),
+				),
+			},
+		},
+	})
+}
+
+// TestAcc_ProjectMembersResource_drift verifies that a user added to the
+// project out-of-band is detected and removed again.
+func TestAcc_ProjectMembersResource_drift(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	config := testAccProjectMembersConfig(nameSuffix, `
+    { user_id = data.semaphoreui_user.admin.id, role = "owner" },
+    { user_id = semaphoreui_user.test.id, role = "guest" },
+  `)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						members := s.RootModule().Resources["semaphoreui_project_members.test"].Primary.Attributes
+						other := s.RootModule().Resources["semaphoreui_user.other"].Primary.Attributes
+						_, err := testClient().Project.PostProjectProjectIDUsers(&project.PostProjectProjectIDUsersParams{
+							ProjectID: testAccInt64Attribute(members, "project_id"),
+							User: project.PostProjectProjectIDUsersBody{
+								UserID: testAccInt64Attribute(other, "id"),
+								Role:   "manager",
+							},
+						}, nil)
+						return err
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_members.test", "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectMembersResource_safeguards(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembersConfig(nameSuffix, `
+    { user_id = data.semaphoreui_user.admin.id, role = "manager" },
+    { user_id = semaphoreui_user.test.id, role = "guest" },
+  `),
+				ExpectError: regexp.MustCompile(`at least one member must be\s+an\s+owner`),
+			},
+			{
+				Config: testAccProjectMembersConfig(nameSuffix, `
+    { user_id = semaphoreui_user.test.id, role = "owner" },
+  `),
+				ExpectError: regexp.MustCompile(`provider credentials and\s+cannot be\s+removed`),
+			},
+		},
+	})
+}
+
+func TestPlanProjectMemberChanges(t *testing.T) {
+	current := projectMembers{1: "owner", 2: "guest", 3: "manager"}
+	planned := projectMembers{1: "guest", 2: "owner", 4: "task_runner"}
+
+	changes, err := planProjectMemberChanges(current, planned, 1)
+	if err != nil {
+		t.Fatalf("planProjectMemberChanges returned an unexpected error: %s", err)
+	}
+	// The user of the credentials is demoted last, once user 2 is an owner.
+	expected := projectMemberChanges{add: []int64{4}, update: []int64{2, 1}, remove: []int64{3}}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("planProjectMemberChanges = %+v, expected %+v", changes, expected)
+	}
+
+	if _, err := planProjectMemberChanges(current, projectMembers{1: "manager", 2: "guest"}, 1); err == nil {
+		t.Error("planProjectMemberChanges expected an error for a project without an owner")
+	}
+	if _, err := planProjectMemberChanges(current, projectMembers{2: "owner"}, 1); err == nil {
+		t.Error("planProjectMemberChanges expected an error when removing the user of the credentials")
+	}
+	// An admin that is not a member of the project can leave it out.
+	if _, err := planProjectMemberChanges(projectMembers{2: "owner"}, projectMembers{3: "owner"}, 1); err != nil {
+		t.Errorf("planProjectMemberChanges returned an unexpected error: %s", err)
+	}
+}
+
+func TestReleaseProjectMembers(t *testing.T) {
+	tests := []struct {
+		current  projectMembers
+		selfID   int64
+		expected []int64
+	}{
+		{projectMembers{1: "owner", 2: "owner", 3: "guest"}, 1, []int64{2, 3}},
+		{projectMembers{1: "guest", 2: "owner", 3: "owner"}, 1, []int64{3}},
+		{projectMembers{2: "owner", 3: "guest"}, 1, []int64{3}},
+	}
+	for _, test := range tests {
+		if got := releaseProjectMembers(test.current, test.selfID); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("releaseProjectMembers(%v, %d) = %v, expected %v", test.current, test.selfID, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type (
+	ProjectMembersModel struct {
+		ProjectID types.Int64 `tfsdk:"project_id"`
+		Members   types.Set   `tfsdk:"members"`
+	}
+
+	ProjectMemberModel struct {
+		UserID types.Int64  `tfsdk:"user_id"`
+		Role   types.String `tfsdk:"role"`
+	}
+)
+
+// projectMemberAttrTypes are the attribute types of the elements of the
+// members set.
+var projectMemberAttrTypes = map[string]attr.Type{
+	"user_id": types.Int64Type,
+	"role":    types.StringType,
+}
+
+func ProjectMembersSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project members",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource manages the full list of members of a project and their roles. It is authoritative: users added to the project outside of Terraform are removed, and must not be managed with `semaphoreui_project_user` resources at the same time. To avoid locking everyone out of the project, the user of the provider credentials is never removed, and the last owner of the project is never removed or demoted.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"project_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "ID of the project.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"members": superschema.SetNestedAttribute{
+				Common: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The members of the project. At least one member must be an `owner`.",
+				},
+				Resource: &schemaR.SetNestedAttribute{
+					Required: true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				Attributes: map[string]superschema.Attribute{
+					"user_id": superschema.Int64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The ID of the user.",
+							Required:            true,
+						},
+					},
+					"role": superschema.StringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "Role of the user in the project.",
+						},
+						Resource: &schemaR.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("owner", "manager", "task_runner", "guest"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
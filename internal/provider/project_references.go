@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectReference is an attribute that holds the ID of another object of the
+// same project, e.g. the ssh_key_id of a repository. kind is the type of the
+// object, as named by importNameLookups.
+type projectReference struct {
+	path path.Path
+	kind string
+}
+
+// validateProjectReferences checks during plan that the objects referenced by
+// a resource exist in its project, so that a mistyped ID or the ID of an
+// object of another project is reported on its attribute before anything is
+// applied, instead of as a rejected request. Only known IDs that are new or
+// changed are checked, and references are not checked when the provider is
+// not configured yet or the objects cannot be listed.
+func validateProjectReferences(ctx context.Context, client *apiclient.SemaphoreUI, req resource.ModifyPlanRequest, references []projectReference) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var projectID, previousProjectID types.Int64
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	if diags.HasError() || projectID.IsNull() || projectID.IsUnknown() {
+		return diags
+	}
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &previousProjectID)...)
+	}
+
+	ids := make(map[string]map[int64]bool)
+	for _, reference := range references {
+		var value, previous types.Int64
+		diags.Append(req.Plan.GetAttribute(ctx, reference.path, &value)...)
+		if !req.State.Raw.IsNull() {
+			diags.Append(req.State.GetAttribute(ctx, reference.path, &previous)...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+		if value.IsNull() || value.IsUnknown() || value.ValueInt64() == 0 {
+			continue
+		}
+		if value.Equal(previous) && projectID.Equal(previousProjectID) {
+			continue
+		}
+
+		if _, ok := ids[reference.kind]; !ok {
+			candidates, err := importNameLookups[reference.kind](client, map[string]int64{"project": projectID.ValueInt64()})
+			if err != nil {
+				if isNotFound(err) {
+					diags.AddAttributeError(
+						path.Root("project_id"),
+						"SemaphoreUI Project Not Found",
+						fmt.Sprintf("Project ID %d does not exist.", projectID.ValueInt64()),
+					)
+					return diags
+				}
+				// Checking the references is only a convenience: leave it to
+				// the API to reject invalid ones.
+				diags.AddWarning(
+					"Unable to Validate SemaphoreUI References",
+					fmt.Sprintf("Could not list the %s objects of project %d: %s", reference.kind, projectID.ValueInt64(), err),
+				)
+				return diags
+			}
+			ids[reference.kind] = make(map[int64]bool, len(candidates))
+			for _, candidate := range candidates {
+				ids[reference.kind][candidate.id] = true
+			}
+		}
+
+		if !ids[reference.kind][value.ValueInt64()] {
+			diags.AddAttributeError(
+				reference.path,
+				"SemaphoreUI Object Not Found in Project",
+				fmt.Sprintf("No %s with ID %d exists in project %d. Check that the ID is correct, and that the %s belongs to the same project as this resource.",
+					reference.kind, value.ValueInt64(), projectID.ValueInt64(), reference.kind),
+			)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/repository"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectRepositoriesDataSource{}
+)
+
+func NewProjectRepositoriesDataSource() datasource.DataSource {
+	return &projectRepositoriesDataSource{}
+}
+
+type projectRepositoriesDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectRepositoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectRepositoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_repositories"
+}
+
+type projectRepositoriesDataSourceModel struct {
+	ProjectID    types.Int64              `tfsdk:"project_id"`
+	NameRegex    types.String             `tfsdk:"name_regex"`
+	Repositories []ProjectRepositoryModel `tfsdk:"repositories"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectRepositoriesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	repositoryAttributes := readOnlyAttributes(ProjectRepositorySchema().GetDataSource(ctx).Attributes)
+	repositoryAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The repository ID.",
+		Computed:            true,
+	}
+	repositoryAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The display name of the repository.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Repositories of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("repositories"),
+			"name_regex": nameRegexAttribute("repositories", "name"),
+			"repositories": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the repositories that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: repositoryAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectRepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectRepositoriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Repository.GetProjectProjectIDRepositories(&repository.GetProjectProjectIDRepositoriesParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Repositories", "Could not read project repositories", err)...)
+		return
+	}
+
+	config.Repositories = []ProjectRepositoryModel{}
+	for _, repository := range response.Payload {
+		if !matchesName(repository.Name) {
+			continue
+		}
+		config.Repositories = append(config.Repositories, convertRepositoryResponseToProjectRepositoryModel(repository))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectRepositoriesDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Repositories DS Project"
+}
+
+resource "semaphoreui_project_key" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "app" {
+  project_id = semaphoreui_project.test.id
+  name       = "app-main"
+  url        = "/path/to/app"
+  branch     = "main"
+  ssh_key_id = semaphoreui_project_key.test.id
+}
+
+resource "semaphoreui_project_repository" "infra" {
+  project_id = semaphoreui_project.test.id
+  name       = "infra"
+  url        = "/path/to/infra"
+  branch     = "main"
+  ssh_key_id = semaphoreui_project_key.test.id
+}
+
+data "semaphoreui_project_repositories" "test" {
+  project_id = semaphoreui_project.test.id
+  name_regex = "^app-"
+  depends_on = [semaphoreui_project_repository.app, semaphoreui_project_repository.infra]
+}`
+}
+
+func TestAcc_ProjectRepositoriesDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRepositoriesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_repositories.test", "repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_repositories.test", "repositories.0.name", "app-main"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_repositories.test", "repositories.0.url", "/path/to/app"),
+				),
+			},
+		},
+	})
+}
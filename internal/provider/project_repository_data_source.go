@@ -27,15 +27,15 @@ func (d *projectRepositoryDataSource) Configure(_ context.Context, req datasourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -53,7 +53,7 @@ func (d *projectRepositoryDataSource) GetRepositoryByName(projectID int64, name
 		ProjectID: projectID,
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read project repositories: %s", err.Error())
+		return nil, fmt.Errorf("could not read project repositories: %w", err)
 	}
 	for _, repo := range response.Payload {
 		if repo.Name == name {
@@ -78,20 +78,14 @@ func (d *projectRepositoryDataSource) Read(ctx context.Context, req datasource.R
 			RepositoryID: config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Repository",
-				"Could not read project repository, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Repository", "Could not read project repository", err)...)
 			return
 		}
 		model = convertRepositoryResponseToProjectRepositoryModel(response.Payload)
 	} else if !config.Name.IsUnknown() && !config.Name.IsNull() {
 		repo, err := d.GetRepositoryByName(config.ProjectID.ValueInt64(), config.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Repository",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Repository", "", err)...)
 			return
 		}
 		model = *repo
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
@@ -14,6 +15,7 @@ var (
 	_ resource.Resource                = &projectRepositoryResource{}
 	_ resource.ResourceWithConfigure   = &projectRepositoryResource{}
 	_ resource.ResourceWithImportState = &projectRepositoryResource{}
+	_ resource.ResourceWithModifyPlan  = &projectRepositoryResource{}
 )
 
 func NewProjectRepositoryResource() resource.Resource {
@@ -29,16 +31,16 @@ func (r *projectRepositoryResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectRepositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,6 +51,12 @@ func (r *projectRepositoryResource) Schema(ctx context.Context, _ resource.Schem
 	resp.Schema = ProjectRepositorySchema().GetResource(ctx)
 }
 
+func (r *projectRepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateProjectReferences(ctx, r.client, req, []projectReference{
+		{path: path.Root("ssh_key_id"), kind: "key"},
+	})...)
+}
+
 func convertProjectRepositoryModelToRepositoryRequest(repo ProjectRepositoryModel) *models.RepositoryRequest {
 	model := models.RepositoryRequest{
 		ProjectID: repo.ProjectID.ValueInt64(),
@@ -87,10 +95,7 @@ func (r *projectRepositoryResource) Create(ctx context.Context, req resource.Cre
 		Repository: convertProjectRepositoryModelToRepositoryRequest(plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Repository",
-			"Could not create project repository, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Repository", "Could not create project repository", err)...)
 		return
 	}
 	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
@@ -116,10 +121,12 @@ func (r *projectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 		RepositoryID: state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Repository",
-			"Could not read project repository, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project repository deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Repository", "Could not read project repository", err)...)
 		return
 	}
 	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
@@ -146,10 +153,7 @@ func (r *projectRepositoryResource) Update(ctx context.Context, req resource.Upd
 		Repository:   convertProjectRepositoryModelToRepositoryRequest(plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Repository",
-			"Could not update project repository, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Repository", "Could not update project repository", err)...)
 		return
 	}
 
@@ -158,10 +162,7 @@ func (r *projectRepositoryResource) Update(ctx context.Context, req resource.Upd
 		RepositoryID: plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Repository",
-			"Could not read project repository, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Repository", "Could not read project repository", err)...)
 		return
 	}
 	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
@@ -184,22 +185,16 @@ func (r *projectRepositoryResource) Delete(ctx context.Context, req resource.Del
 		ProjectID:    state.ProjectID.ValueInt64(),
 		RepositoryID: state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Project Repository",
-			"Could not remove project repository, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project Repository", "Could not remove project repository", err)...)
 		return
 	}
 }
 
 func (r *projectRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "repository"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "repository"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Repository Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Repository Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -208,10 +203,7 @@ func (r *projectRepositoryResource) ImportState(ctx context.Context, req resourc
 		RepositoryID: fields["repository"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Repository",
-			"Could not read project repository, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Repository", "Could not read project repository", err)...)
 		return
 	}
 	model := convertRepositoryResponseToProjectRepositoryModel(response.Payload)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/repository"
 	"testing"
@@ -132,3 +133,68 @@ func TestAcc_ProjectRepositoryResource_basic(t *testing.T) {
 		},
 	})
 }
+
+// TestAcc_ProjectRepositoryResource_disappears verifies that a project repository deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectRepositoryResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRepositoryConfig(nameSuffix, "https://github.com/semaphoreui/semaphore.git", "develop"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectRepositoryExists("semaphoreui_project_repository.test"),
+					testAccDeleteOutOfBand("semaphoreui_project_repository.test", func(attributes map[string]string) error {
+						_, err := testClient().Repository.DeleteProjectProjectIDRepositoriesRepositoryID(&repository.DeleteProjectProjectIDRepositoriesRepositoryIDParams{
+							ProjectID:    testAccInt64Attribute(attributes, "project_id"),
+							RepositoryID: testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestAcc_ProjectRepositoryResource_foreignKey verifies that an SSH key of
+// another project is rejected at plan time.
+func TestAcc_ProjectRepositoryResource_foreignKey(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	otherProjectConfig := fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project" "other" {
+  name = "other-%[2]s"
+}
+
+resource "semaphoreui_project_key" "other" {
+  project_id = semaphoreui_project.other.id
+  name       = "other-%[2]s"
+  none = {}
+}
+`, testAccProjectRepositoryEmptyConfig(nameSuffix), nameSuffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: otherProjectConfig,
+			},
+			{
+				Config: otherProjectConfig + `
+resource "semaphoreui_project_repository" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Test"
+  url        = "https://github.com/semaphoreui/semaphore.git"
+  branch     = "develop"
+  ssh_key_id = semaphoreui_project_key.other.id
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No key with ID \d+ exists in project \d+`),
+			},
+		},
+	})
+}
@@ -31,16 +31,16 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -90,10 +90,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	//Create new project
 	response, err := r.client.Project.PostProjects(&project.PostProjectsParams{Project: &request}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Semaphore Project",
-			"Could not create project, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating Semaphore Project", "Could not create project", err)...)
 		return
 	}
 
@@ -118,10 +115,12 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	response, err := r.client.Project.GetProjectProjectID(&project.GetProjectProjectIDParams{ProjectID: state.ID.ValueInt64()}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project",
-			fmt.Sprintf("Could not read project ID %d: %s", state.ID.ValueInt64(), err.Error()),
-		)
+		if isNotFound(err) {
+			// Drift: project deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project", fmt.Sprintf("Could not read project ID %d", state.ID.ValueInt64()), err)...)
 		return
 	}
 
@@ -157,20 +156,14 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update existing project
 	_, err := r.client.Project.PutProjectProjectID(&project.PutProjectProjectIDParams{ProjectID: plan.ID.ValueInt64(), Project: request}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Semaphore Project",
-			fmt.Sprintf("Could not update project, unexpected error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating Semaphore Project", "Could not update project", err)...)
 		return
 	}
 
 	// Fetch updated project as PutProjectProjectID does not return updated project
 	response, err := r.client.Project.GetProjectProjectID(&project.GetProjectProjectIDParams{ProjectID: plan.ID.ValueInt64()}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project",
-			fmt.Sprintf("Could not read project ID %d: %s", plan.ID.ValueInt64(), err.Error()),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project", fmt.Sprintf("Could not read project ID %d", plan.ID.ValueInt64()), err)...)
 		return
 	}
 
@@ -195,22 +188,16 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	// Delete existing order
 	_, err := r.client.Project.DeleteProjectProjectID(&project.DeleteProjectProjectIDParams{ProjectID: state.ID.ValueInt64()}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Semaphore Project",
-			fmt.Sprintf("Could not delete project, unexpected error: %s", err.Error()),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting Semaphore Project", "Could not delete project", err)...)
 		return
 	}
 }
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Import ID", "Could not parse import ID", err)...)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fields["project"])...)
@@ -94,3 +94,28 @@ alert_chat = "testing"`),
 		},
 	})
 }
+
+// TestAcc_ProjectResource_disappears verifies that a project deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig(nameSuffix, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectExists("semaphoreui_project.test"),
+					testAccDeleteOutOfBand("semaphoreui_project.test", func(attributes map[string]string) error {
+						_, err := testClient().Project.DeleteProjectProjectID(&project.DeleteProjectProjectIDParams{
+							ProjectID: testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
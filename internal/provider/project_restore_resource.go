@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/inventory"
+	"terraform-provider-semaphoreui/semaphoreui/client/key_store"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/client/repository"
+	"terraform-provider-semaphoreui/semaphoreui/client/template"
+	"terraform-provider-semaphoreui/semaphoreui/client/variable_group"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &projectRestoreResource{}
+	_ resource.ResourceWithConfigure = &projectRestoreResource{}
+)
+
+func NewProjectRestoreResource() resource.Resource {
+	return &projectRestoreResource{}
+}
+
+type projectRestoreResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectRestoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *projectRestoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_restore"
+}
+
+func (r *projectRestoreResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectRestoreSchema().GetResource(ctx)
+}
+
+// addProjectRestoreObjectID adds the ID of an object to ids by its name. The
+// name is the only way to tell the objects apart, so a name used by several
+// objects of the same type is an error.
+func addProjectRestoreObjectID(ids map[string]int64, objectType string, name string, id int64) error {
+	if otherID, ok := ids[name]; ok {
+		return fmt.Errorf("the project has several %s named %q (IDs %d and %d), so they cannot be told apart by name; rename one of them", objectType, name, otherID, id)
+	}
+	ids[name] = id
+	return nil
+}
+
+// GetObjectIDs lists the objects of the project and returns their IDs by name
+// (by title for views), so that objects created from the backup, which only
+// references objects by name, can be used by other resources.
+func (r *projectRestoreResource) GetObjectIDs(projectID int64) (map[string]map[string]int64, error) {
+	ids := map[string]map[string]int64{
+		"key_ids":         {},
+		"repository_ids":  {},
+		"inventory_ids":   {},
+		"environment_ids": {},
+		"template_ids":    {},
+		"view_ids":        {},
+	}
+
+	keys, err := r.client.KeyStore.GetProjectProjectIDKeys(&key_store.GetProjectProjectIDKeysParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project keys: %w", err)
+	}
+	for _, key := range keys.Payload {
+		if err := addProjectRestoreObjectID(ids["key_ids"], "access keys", key.Name, key.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	repositories, err := r.client.Repository.GetProjectProjectIDRepositories(&repository.GetProjectProjectIDRepositoriesParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project repositories: %w", err)
+	}
+	for _, repository := range repositories.Payload {
+		if err := addProjectRestoreObjectID(ids["repository_ids"], "repositories", repository.Name, repository.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	inventories, err := r.client.Inventory.GetProjectProjectIDInventory(&inventory.GetProjectProjectIDInventoryParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project inventories: %w", err)
+	}
+	for _, inventory := range inventories.Payload {
+		if err := addProjectRestoreObjectID(ids["inventory_ids"], "inventories", inventory.Name, inventory.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	environments, err := r.client.VariableGroup.GetProjectProjectIDEnvironment(&variable_group.GetProjectProjectIDEnvironmentParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project environments: %w", err)
+	}
+	for _, environment := range environments.Payload {
+		if err := addProjectRestoreObjectID(ids["environment_ids"], "environments", environment.Name, environment.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	templates, err := r.client.Template.GetProjectProjectIDTemplates(&template.GetProjectProjectIDTemplatesParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project templates: %w", err)
+	}
+	for _, template := range templates.Payload {
+		if err := addProjectRestoreObjectID(ids["template_ids"], "templates", template.Name, template.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	views, err := r.client.Project.GetProjectProjectIDViews(&project.GetProjectProjectIDViewsParams{ProjectID: projectID}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project views: %w", err)
+	}
+	for _, view := range views.Payload {
+		if err := addProjectRestoreObjectID(ids["view_ids"], "views", view.Title, view.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// readProjectRestore refreshes the object IDs of the model, and the project
+// name if it is not known yet. A project renamed outside of Terraform keeps
+// its name in the state with a warning, as a new name would force the project
+// to be deleted and restored again. It returns false when the project no
+// longer exists.
+func (r *projectRestoreResource) readProjectRestore(ctx context.Context, model *ProjectRestoreModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	response, err := r.client.Project.GetProjectProjectID(&project.GetProjectProjectIDParams{ProjectID: model.ID.ValueInt64()}, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+		diags.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project", fmt.Sprintf("Could not read project ID %d", model.ID.ValueInt64()), err)...)
+		return true, diags
+	}
+	if model.Name.IsNull() || model.Name.IsUnknown() {
+		model.Name = types.StringValue(response.Payload.Name)
+	} else if model.Name.ValueString() != response.Payload.Name {
+		diags.AddAttributeWarning(
+			path.Root("name"),
+			"SemaphoreUI Project Renamed",
+			fmt.Sprintf("The restored project ID %d was renamed from %q to %q outside of Terraform. The name is not updated in the Terraform state, as changing it would delete the project and restore it again.", model.ID.ValueInt64(), model.Name.ValueString(), response.Payload.Name),
+		)
+	}
+
+	ids, err := r.GetObjectIDs(model.ID.ValueInt64())
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Objects", "", err)...)
+		return true, diags
+	}
+
+	var d diag.Diagnostics
+	model.KeyIDs, d = types.MapValueFrom(ctx, types.Int64Type, ids["key_ids"])
+	diags.Append(d...)
+	model.RepositoryIDs, d = types.MapValueFrom(ctx, types.Int64Type, ids["repository_ids"])
+	diags.Append(d...)
+	model.InventoryIDs, d = types.MapValueFrom(ctx, types.Int64Type, ids["inventory_ids"])
+	diags.Append(d...)
+	model.EnvironmentIDs, d = types.MapValueFrom(ctx, types.Int64Type, ids["environment_ids"])
+	diags.Append(d...)
+	model.TemplateIDs, d = types.MapValueFrom(ctx, types.Int64Type, ids["template_ids"])
+	diags.Append(d...)
+	model.ViewIDs, d = types.MapValueFrom(ctx, types.Int64Type, ids["view_ids"])
+	diags.Append(d...)
+	return true, diags
+}
+
+func (r *projectRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var backup models.ProjectBackup
+	if err := json.Unmarshal([]byte(plan.Backup.ValueString()), &backup); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid SemaphoreUI Project Backup",
+			"Could not decode project backup JSON: "+err.Error(),
+		)
+		return
+	}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		if backup.Meta == nil {
+			backup.Meta = &models.ProjectBackupMeta{}
+		}
+		backup.Meta.Name = plan.Name.ValueString()
+	}
+
+	response, err := r.client.Project.PostProjectsRestore(&project.PostProjectsRestoreParams{
+		Backup: &backup,
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Restoring SemaphoreUI Project", "Could not restore project", err)...)
+		return
+	}
+
+	plan.ID = types.Int64Value(response.Payload.ID)
+	found, diags := r.readProjectRestore(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Error Reading SemaphoreUI Project",
+			fmt.Sprintf("The restored project ID %d could not be found.", plan.ID.ValueInt64()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		// Keep the project in state so that it is deleted on destroy.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backup"), plan.Backup)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *projectRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectRestoreModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readProjectRestore(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		// Drift: project deleted out-of-band. Remove from state.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never reached: every configurable attribute forces replacement,
+// so a new project is always restored via Create. It is implemented
+// defensively to preserve the computed values if it ever runs.
+func (r *projectRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Backup = plan.Backup
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *projectRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectRestoreModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Project.DeleteProjectProjectID(&project.DeleteProjectProjectIDParams{ProjectID: state.ID.ValueInt64()}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting SemaphoreUI Project", "Could not delete project", err)...)
+		return
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccProjectRestoreExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		id, _ := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		response, err := testClient().Project.GetProjectProjectID(&project.GetProjectProjectIDParams{
+			ProjectID: id,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not read restored project: %s", err.Error())
+		}
+		if response.Payload.Name != rs.Primary.Attributes["name"] {
+			return fmt.Errorf("expected project name %s, got %s", rs.Primary.Attributes["name"], response.Payload.Name)
+		}
+		return nil
+	}
+}
+
+func testAccProjectRestoreConfig(nameSuffix string, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "semaphoreui_project_backup" "test" {
+  project_id = semaphoreui_project.test.id
+
+  depends_on = [semaphoreui_project_template.test]
+}
+
+resource "semaphoreui_project_restore" "test" {
+  backup = data.semaphoreui_project_backup.test.json
+  name   = "%[2]s"
+}
+`, testAccProjectTemplateConfig(nameSuffix, ""), name)
+}
+
+func TestAcc_ProjectRestoreResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectRestoreConfig(nameSuffix, "Restored "+nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectRestoreExists("semaphoreui_project_restore.test"),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_restore.test", "id"),
+					resource.TestCheckResourceAttr("semaphoreui_project_restore.test", "name", "Restored "+nameSuffix),
+					resource.TestCheckResourceAttrSet("semaphoreui_project_restore.test", fmt.Sprintf("template_ids.Test %s", nameSuffix)),
+					resource.TestCheckResourceAttr("semaphoreui_project_restore.test", "repository_ids.%", "1"),
+				),
+			},
+			// Changing the name restores a new project
+			{
+				Config: testAccProjectRestoreConfig(nameSuffix, "Renamed "+nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectRestoreExists("semaphoreui_project_restore.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_restore.test", "name", "Renamed "+nameSuffix),
+				),
+			},
+			// Renaming the project outside of Terraform keeps it
+			{
+				Config: testAccProjectRestoreConfig(nameSuffix, "Renamed "+nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						restore := s.RootModule().Resources["semaphoreui_project_restore.test"].Primary.Attributes
+						_, err := testClient().Project.PutProjectProjectID(&project.PutProjectProjectIDParams{
+							ProjectID: testAccInt64Attribute(restore, "id"),
+							Project: project.PutProjectProjectIDBody{
+								ID: testAccInt64Attribute(restore, "id"),
+								ProjectRequest: models.ProjectRequest{
+									Name: "Elsewhere " + nameSuffix,
+								},
+							},
+						}, nil)
+						return err
+					},
+				),
+			},
+			{
+				Config:   testAccProjectRestoreConfig(nameSuffix, "Renamed "+nameSuffix),
+				PlanOnly: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAcc_ProjectRestoreResource_duplicateNames(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "semaphoreui_project_restore" "test" {
+  backup = jsonencode({
+    meta = {
+      name = "Duplicate %[1]s"
+    }
+    keys = [
+      { name = "Key", type = "none" },
+      { name = "Key", type = "none" },
+    ]
+  })
+}
+`, nameSuffix),
+				ExpectError: regexp.MustCompile(`several\s+access\s+keys\s+named\s+"Key"`),
+			},
+		},
+	})
+}
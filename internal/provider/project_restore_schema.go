@@ -0,0 +1,87 @@
+package provider
+
+import (
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type ProjectRestoreModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Backup         types.String `tfsdk:"backup"`
+	Name           types.String `tfsdk:"name"`
+	KeyIDs         types.Map    `tfsdk:"key_ids"`
+	RepositoryIDs  types.Map    `tfsdk:"repository_ids"`
+	InventoryIDs   types.Map    `tfsdk:"inventory_ids"`
+	EnvironmentIDs types.Map    `tfsdk:"environment_ids"`
+	TemplateIDs    types.Map    `tfsdk:"template_ids"`
+	ViewIDs        types.Map    `tfsdk:"view_ids"`
+}
+
+// projectRestoreIDsAttribute returns a computed map from object name to ID
+// for one type of object of the restored project.
+func projectRestoreIDsAttribute(description string) superschema.MapAttribute {
+	return superschema.MapAttribute{
+		Common: &schemaR.MapAttribute{
+			MarkdownDescription: description,
+			ElementType:         types.Int64Type,
+		},
+		Resource: &schemaR.MapAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func ProjectRestoreSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project restore",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to create a new project from a project backup, such as one exported with the `semaphoreui_project_backup` data source. Changing the backup creates a new project. Destroying the resource deletes the project and everything in it. The objects of the project are looked up by name, so objects of the same type must have unique names.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The ID of the restored project.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+			"backup": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The project backup as JSON, e.g. `file(\"golden.json\")`, the `json` attribute of a `semaphoreui_project_backup` data source, or a structured backup written in HCL and passed through `jsonencode()`.",
+					Sensitive:           true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Required:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the restored project. Defaults to the project name stored in the backup. Renaming the project outside of Terraform is reported as a warning and does not replace it.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"key_ids":         projectRestoreIDsAttribute("The IDs of the access keys of the restored project, by name."),
+			"repository_ids":  projectRestoreIDsAttribute("The IDs of the repositories of the restored project, by name."),
+			"inventory_ids":   projectRestoreIDsAttribute("The IDs of the inventories of the restored project, by name."),
+			"environment_ids": projectRestoreIDsAttribute("The IDs of the environments of the restored project, by name."),
+			"template_ids":    projectRestoreIDsAttribute("The IDs of the templates of the restored project, by name."),
+			"view_ids":        projectRestoreIDsAttribute("The IDs of the views of the restored project, by title."),
+		},
+	}
+}
@@ -27,15 +27,15 @@ func (d *projectRunnerDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 func (d *projectRunnerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -59,10 +59,7 @@ func (d *projectRunnerDataSource) Read(ctx context.Context, req datasource.ReadR
 			RunnerID:  config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Runner",
-				"Could not read project runner, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Runner", "Could not read project runner", err)...)
 			return
 		}
 		model, diags := convertRunnerResponseToProjectRunnerModel(ctx, response.Payload, config.ProjectID)
@@ -78,10 +75,7 @@ func (d *projectRunnerDataSource) Read(ctx context.Context, req datasource.ReadR
 		ProjectID: config.ProjectID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Runners",
-			"Could not read project runners, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Runners", "Could not read project runners", err)...)
 		return
 	}
 	for _, item := range response.Payload {
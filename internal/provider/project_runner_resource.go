@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +15,7 @@ var (
 	_ resource.Resource                = &projectRunnerResource{}
 	_ resource.ResourceWithConfigure   = &projectRunnerResource{}
 	_ resource.ResourceWithImportState = &projectRunnerResource{}
+	_ resource.ResourceWithModifyPlan  = &projectRunnerResource{}
 )
 
 func NewProjectRunnerResource() resource.Resource {
@@ -23,7 +23,8 @@ func NewProjectRunnerResource() resource.Resource {
 }
 
 type projectRunnerResource struct {
-	client *apiclient.SemaphoreUI
+	client     *apiclient.SemaphoreUI
+	serverInfo *models.InfoType
 }
 
 func (r *projectRunnerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -31,16 +32,17 @@ func (r *projectRunnerResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
+	r.serverInfo = data.serverInfo
 }
 
 func (r *projectRunnerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,6 +97,12 @@ func convertRunnerResponseToProjectRunnerModel(ctx context.Context, response *mo
 	return model, diags
 }
 
+// ModifyPlan warns at plan time when remote runners are disabled on the
+// server.
+func (r *projectRunnerResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnRemoteRunnersDisabledOnCreate(r.serverInfo, "A project runner", req, resp)
+}
+
 func (r *projectRunnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ProjectRunnerModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -113,18 +121,12 @@ func (r *projectRunnerResource) Create(ctx context.Context, req resource.CreateR
 		Runner:    request,
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Runner",
-			"Could not create project runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Runner", "Could not create project runner", err)...)
 		return
 	}
 
 	if err := r.ensureActive(plan.ProjectID.ValueInt64(), response.Payload.ID, plan.Active.ValueBool(), response.Payload.Active); err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting SemaphoreUI Project Runner Active State",
-			"Could not set project runner active state, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting SemaphoreUI Project Runner Active State", "Could not set project runner active state", err)...)
 		return
 	}
 
@@ -167,16 +169,12 @@ func (r *projectRunnerResource) Read(ctx context.Context, req resource.ReadReque
 		RunnerID:  state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		var notFound *runner.GetProjectProjectIDRunnersRunnerIDNotFound
-		if errors.As(err, &notFound) {
+		if isNotFound(err) {
 			// Drift: runner deleted out-of-band. Remove from state.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Runner",
-			"Could not read project runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Runner", "Could not read project runner", err)...)
 		return
 	}
 
@@ -209,10 +207,7 @@ func (r *projectRunnerResource) Update(ctx context.Context, req resource.UpdateR
 		Runner:    request,
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Runner",
-			"Could not update project runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Runner", "Could not update project runner", err)...)
 		return
 	}
 
@@ -221,18 +216,12 @@ func (r *projectRunnerResource) Update(ctx context.Context, req resource.UpdateR
 		RunnerID:  plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Runner",
-			"Could not read project runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Runner", "Could not read project runner", err)...)
 		return
 	}
 
 	if err := r.ensureActive(plan.ProjectID.ValueInt64(), plan.ID.ValueInt64(), plan.Active.ValueBool(), response.Payload.Active); err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting SemaphoreUI Project Runner Active State",
-			"Could not set project runner active state, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting SemaphoreUI Project Runner Active State", "Could not set project runner active state", err)...)
 		return
 	}
 
@@ -257,22 +246,16 @@ func (r *projectRunnerResource) Delete(ctx context.Context, req resource.DeleteR
 		ProjectID: state.ProjectID.ValueInt64(),
 		RunnerID:  state.ID.ValueInt64(),
 	}, nil)
-	if err != nil && !isRunnerNotFound(err) {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Project Runner",
-			"Could not remove project runner, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project Runner", "Could not remove project runner", err)...)
 		return
 	}
 }
 
 func (r *projectRunnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "runner"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "runner"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Runner Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Runner Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -281,10 +264,7 @@ func (r *projectRunnerResource) ImportState(ctx context.Context, req resource.Im
 		RunnerID:  fields["runner"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Runner",
-			"Could not read project runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Runner", "Could not read project runner", err)...)
 		return
 	}
 
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/runner"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectRunnersDataSource{}
+)
+
+func NewProjectRunnersDataSource() datasource.DataSource {
+	return &projectRunnersDataSource{}
+}
+
+type projectRunnersDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectRunnersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectRunnersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_runners"
+}
+
+type projectRunnersDataSourceModel struct {
+	ProjectID types.Int64          `tfsdk:"project_id"`
+	NameRegex types.String         `tfsdk:"name_regex"`
+	Runners   []ProjectRunnerModel `tfsdk:"runners"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectRunnersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	runnerAttributes := readOnlyAttributes(ProjectRunnerSchema().GetDataSource(ctx).Attributes)
+	runnerAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The runner ID.",
+		Computed:            true,
+	}
+	runnerAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The display name of the runner.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Runners of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("runners"),
+			"name_regex": nameRegexAttribute("runners", "name"),
+			"runners": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the runners that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: runnerAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectRunnersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectRunnersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Runner.GetProjectProjectIDRunners(&runner.GetProjectProjectIDRunnersParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Runners", "Could not read project runners", err)...)
+		return
+	}
+
+	config.Runners = []ProjectRunnerModel{}
+	for _, item := range response.Payload {
+		if !matchesName(item.Name) {
+			continue
+		}
+		// The list endpoint returns bare Runner objects (no token/private
+		// key); wrap so the shared converter leaves those fields null.
+		model, diags := convertRunnerResponseToProjectRunnerModel(ctx, &models.RunnerWithToken{Runner: *item}, config.ProjectID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config.Runners = append(config.Runners, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectRunnersDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Runners DS Project"
+}
+
+resource "semaphoreui_project_runner" "linux" {
+  project_id = semaphoreui_project.test.id
+  name       = "linux-1"
+  tags       = ["linux"]
+}
+
+resource "semaphoreui_project_runner" "windows" {
+  project_id = semaphoreui_project.test.id
+  name       = "windows-1"
+}
+
+data "semaphoreui_project_runners" "test" {
+  project_id = semaphoreui_project.test.id
+  name_regex = "^linux-"
+  depends_on = [semaphoreui_project_runner.linux, semaphoreui_project_runner.windows]
+}`
+}
+
+func TestAcc_ProjectRunnersDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRunnersDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_runners.test", "runners.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_runners.test", "runners.0.name", "linux-1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_runners.test", "runners.0.tags.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -26,15 +27,15 @@ func (d *projectScheduleDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -59,13 +60,10 @@ func (d *projectScheduleDataSource) Read(ctx context.Context, req datasource.Rea
 		ScheduleID: config.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Schedule",
-			"Could not read project schedule, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Schedule", "Could not read project schedule", err)...)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, types.StringNull())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
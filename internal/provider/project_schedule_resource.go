@@ -2,18 +2,23 @@ package provider
 
 import (
 	"context"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
 	"terraform-provider-semaphoreui/semaphoreui/models"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &projectScheduleResource{}
-	_ resource.ResourceWithConfigure   = &projectScheduleResource{}
-	_ resource.ResourceWithImportState = &projectScheduleResource{}
+	_ resource.Resource                     = &projectScheduleResource{}
+	_ resource.ResourceWithConfigure        = &projectScheduleResource{}
+	_ resource.ResourceWithImportState      = &projectScheduleResource{}
+	_ resource.ResourceWithConfigValidators = &projectScheduleResource{}
 )
 
 func NewProjectScheduleResource() resource.Resource {
@@ -29,16 +34,16 @@ func (r *projectScheduleResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,29 +54,59 @@ func (r *projectScheduleResource) Schema(ctx context.Context, _ resource.SchemaR
 	resp.Schema = ProjectScheduleSchema().GetResource(ctx)
 }
 
-func convertProjectScheduleModelToRepositorySchedule(schedule ProjectScheduleModel) *models.ScheduleRequest {
+func (r *projectScheduleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("cron_format"),
+			path.MatchRoot("run_at"),
+		),
+	}
+}
+
+func convertProjectScheduleModelToRepositorySchedule(ctx context.Context, schedule ProjectScheduleModel) *models.ScheduleRequest {
 	model := models.ScheduleRequest{
 		ProjectID:  schedule.ProjectID.ValueInt64(),
 		TemplateID: schedule.TemplateID.ValueInt64(),
 		Name:       schedule.Name.ValueString(),
 		CronFormat: schedule.CronFormat.ValueString(),
 		Active:     schedule.Enabled.ValueBool(),
+		TaskParams: convertTaskParamsModelToTaskPrams(ctx, schedule.TaskParams),
 	}
 	if !schedule.ID.IsNull() && !schedule.ID.IsUnknown() {
 		model.ID = schedule.ID.ValueInt64()
 	}
+	if !schedule.RunAt.IsNull() && !schedule.RunAt.IsUnknown() {
+		// The value has been validated by the schema.
+		runAt, _ := strfmt.ParseDateTime(schedule.RunAt.ValueString())
+		model.Type = models.ScheduleRequestTypeRunAt
+		model.RunAt = runAt
+	}
 	return &model
 }
 
-func convertScheduleResponseToProjectScheduleModel(request *models.Schedule) ProjectScheduleModel {
-	return ProjectScheduleModel{
+// convertScheduleResponseToProjectScheduleModel converts a schedule returned
+// by the API. SemaphoreUI returns run_at in its own format, so the run_at of
+// the configuration or state, if given, is kept when it is the same time.
+func convertScheduleResponseToProjectScheduleModel(ctx context.Context, request *models.Schedule, runAt types.String) ProjectScheduleModel {
+	model := ProjectScheduleModel{
 		ID:         types.Int64Value(request.ID),
 		ProjectID:  types.Int64Value(request.ProjectID),
 		TemplateID: types.Int64Value(request.TemplateID),
 		Name:       types.StringValue(request.Name),
-		CronFormat: types.StringValue(request.CronFormat),
+		CronFormat: types.StringNull(),
+		RunAt:      types.StringNull(),
 		Enabled:    types.BoolValue(request.Active),
+		TaskParams: convertTaskPramsToTaskParamsModel(ctx, request.TaskParams),
+	}
+	if request.Type == models.ScheduleTypeRunAt {
+		model.RunAt = types.StringValue(time.Time(request.RunAt).UTC().Format(time.RFC3339))
+		if previous, err := strfmt.ParseDateTime(runAt.ValueString()); err == nil && previous.Equal(request.RunAt) {
+			model.RunAt = runAt
+		}
+	} else {
+		model.CronFormat = types.StringValue(request.CronFormat)
 	}
+	return model
 }
 
 func (r *projectScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -84,16 +119,13 @@ func (r *projectScheduleResource) Create(ctx context.Context, req resource.Creat
 
 	response, err := r.client.Schedule.PostProjectProjectIDSchedules(&schedule.PostProjectProjectIDSchedulesParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
-		Schedule:  convertProjectScheduleModelToRepositorySchedule(plan),
+		Schedule:  convertProjectScheduleModelToRepositorySchedule(ctx, plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Schedule",
-			"Could not create project schedule, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Schedule", "Could not create project schedule", err)...)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, plan.RunAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -115,13 +147,15 @@ func (r *projectScheduleResource) Read(ctx context.Context, req resource.ReadReq
 		ScheduleID: state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Schedule",
-			"Could not read project schedule, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project schedule deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Schedule", "Could not read project schedule", err)...)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, state.RunAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -141,13 +175,10 @@ func (r *projectScheduleResource) Update(ctx context.Context, req resource.Updat
 	_, err := r.client.Schedule.PutProjectProjectIDSchedulesScheduleID(&schedule.PutProjectProjectIDSchedulesScheduleIDParams{
 		ProjectID:  plan.ProjectID.ValueInt64(),
 		ScheduleID: plan.ID.ValueInt64(),
-		Schedule:   convertProjectScheduleModelToRepositorySchedule(plan),
+		Schedule:   convertProjectScheduleModelToRepositorySchedule(ctx, plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Schedule",
-			"Could not update project schedule, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Schedule", "Could not update project schedule", err)...)
 		return
 	}
 
@@ -156,13 +187,10 @@ func (r *projectScheduleResource) Update(ctx context.Context, req resource.Updat
 		ScheduleID: plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Schedule",
-			"Could not read project schedule, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Schedule", "Could not read project schedule", err)...)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, plan.RunAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 	if resp.Diagnostics.HasError() {
@@ -182,22 +210,16 @@ func (r *projectScheduleResource) Delete(ctx context.Context, req resource.Delet
 		ProjectID:  state.ProjectID.ValueInt64(),
 		ScheduleID: state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Project Schedule",
-			"Could not remove project schedule, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project Schedule", "Could not remove project schedule", err)...)
 		return
 	}
 }
 
 func (r *projectScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "schedule"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "schedule"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Repository Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Repository Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -206,13 +228,10 @@ func (r *projectScheduleResource) ImportState(ctx context.Context, req resource.
 		ScheduleID: fields["schedule"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Schedule",
-			"Could not read project schedule, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Schedule", "Could not read project schedule", err)...)
 		return
 	}
-	model := convertScheduleResponseToProjectScheduleModel(response.Payload)
+	model := convertScheduleResponseToProjectScheduleModel(ctx, response.Payload, types.StringNull())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 	if resp.Diagnostics.HasError() {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/schedule"
 	"testing"
@@ -107,6 +108,20 @@ resource "semaphoreui_project_schedule" "test" {
 `, testAccProjectScheduleDependencyConfig(nameSuffix), nameSuffix, enabled)
 }
 
+func testAccProjectScheduleRunAtConfig(nameSuffix string, runAt string, extras string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_schedule" "test" {
+  project_id  = semaphoreui_project.test.id
+  name        = "Test %[2]s"
+  template_id = semaphoreui_project_template.test.id
+  run_at      = "%[3]s"
+  enabled     = true
+  %[4]s
+}
+`, testAccProjectScheduleDependencyConfig(nameSuffix), nameSuffix, runAt, extras)
+}
+
 func testAccProjectScheduleImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
@@ -167,3 +182,102 @@ func TestAcc_ProjectScheduleResource_basic(t *testing.T) {
 		},
 	})
 }
+
+// TestAcc_ProjectScheduleResource_disappears verifies that a project schedule deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectScheduleResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectScheduleConfig(nameSuffix, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectScheduleExists("semaphoreui_project_schedule.test"),
+					testAccDeleteOutOfBand("semaphoreui_project_schedule.test", func(attributes map[string]string) error {
+						_, err := testClient().Schedule.DeleteProjectProjectIDSchedulesScheduleID(&schedule.DeleteProjectProjectIDSchedulesScheduleIDParams{
+							ProjectID:  testAccInt64Attribute(attributes, "project_id"),
+							ScheduleID: testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectScheduleResource_runAt(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a one-time schedule with task_params.
+			{
+				Config: testAccProjectScheduleRunAtConfig(nameSuffix, "2099-01-31T22:00:00Z", `
+  task_params = {
+    git_branch = "maintenance"
+    ansible = {
+      limit   = ["db"]
+      dry_run = true
+    }
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectScheduleExists("semaphoreui_project_schedule.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "run_at", "2099-01-31T22:00:00Z"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_schedule.test", "cron_format"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.git_branch", "maintenance"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.ansible.limit.#", "1"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.ansible.limit.0", "db"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "task_params.ansible.dry_run", "true"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "semaphoreui_project_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccProjectScheduleImportID("semaphoreui_project_schedule.test"),
+			},
+			// The same time in another time zone is kept as configured.
+			{
+				Config: testAccProjectScheduleRunAtConfig(nameSuffix, "2099-02-01T00:00:00+02:00", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "run_at", "2099-02-01T00:00:00+02:00"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_schedule.test", "task_params"),
+				),
+			},
+			// Switch to a recurring schedule.
+			{
+				Config: testAccProjectScheduleConfig(nameSuffix, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectScheduleExists("semaphoreui_project_schedule.test"),
+					resource.TestCheckResourceAttr("semaphoreui_project_schedule.test", "cron_format", "0 0 * * *"),
+					resource.TestCheckNoResourceAttr("semaphoreui_project_schedule.test", "run_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectScheduleResource_cronFormatOrRunAt(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectScheduleRunAtConfig(nameSuffix, "2099-01-31T22:00:00Z", `cron_format = "0 0 * * *"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccProjectScheduleRunAtConfig(nameSuffix, "tomorrow", ""),
+				ExpectError: regexp.MustCompile(`must be a valid RFC 3339 timestamp`),
+			},
+		},
+	})
+}
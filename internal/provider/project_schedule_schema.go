@@ -18,7 +18,10 @@ type (
 		TemplateID types.Int64  `tfsdk:"template_id"`
 		Name       types.String `tfsdk:"name"`
 		CronFormat types.String `tfsdk:"cron_format"`
+		RunAt      types.String `tfsdk:"run_at"`
 		Enabled    types.Bool   `tfsdk:"enabled"`
+
+		TaskParams *TaskParamsModel `tfsdk:"task_params"`
 	}
 )
 
@@ -80,10 +83,10 @@ func ProjectScheduleSchema() superschema.Schema {
 			},
 			"cron_format": superschema.StringAttribute{
 				Common: &schemaR.StringAttribute{
-					MarkdownDescription: "The cron format of the schedule.",
+					MarkdownDescription: "The cron format of the schedule, for a recurring schedule. Exactly one of `cron_format` and `run_at` must be set.",
 				},
 				Resource: &schemaR.StringAttribute{
-					Required: true,
+					Optional: true,
 					Validators: []validator.String{
 						stringvalidator.CronFormat(),
 					},
@@ -92,6 +95,20 @@ func ProjectScheduleSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			"run_at": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The time at which the schedule runs the template once, as an RFC 3339 timestamp, e.g. `2025-01-31T22:00:00Z`. Exactly one of `cron_format` and `run_at` must be set.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.RFC3339(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
 			"enabled": superschema.BoolAttribute{
 				Common: &schemaR.BoolAttribute{
 					MarkdownDescription: "Whether the schedule is enabled.",
@@ -103,6 +120,7 @@ func ProjectScheduleSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			"task_params": TaskParamsAttribute(),
 		},
 	}
 }
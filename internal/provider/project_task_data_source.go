@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/task"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultRawOutputMaxBytes caps the raw task output read into state when
+// raw_output_max_bytes is not set.
+const defaultRawOutputMaxBytes = 1024 * 1024
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectTaskDataSource{}
+)
+
+func NewProjectTaskDataSource() datasource.DataSource {
+	return &projectTaskDataSource{}
+}
+
+type projectTaskDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectTaskDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectTaskDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_task"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectTaskDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ProjectTaskDataSourceSchema().GetDataSource(ctx)
+}
+
+// GetLatestTask returns the most recent task of the template, optionally
+// restricted to tasks with the given status. Only the tasks returned by the
+// "last tasks" endpoint of the project are considered.
+func (d *projectTaskDataSource) GetLatestTask(projectID, templateID int64, status string) (*models.Task, error) {
+	response, err := d.client.Task.GetProjectProjectIDTasksLast(&task.GetProjectProjectIDTasksLastParams{
+		ProjectID: projectID,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project tasks: %w", err)
+	}
+
+	var latest *models.Task
+	for _, t := range response.Payload {
+		if t.TemplateID != templateID || (status != "" && t.Status != status) {
+			continue
+		}
+		if latest == nil || t.ID > latest.ID {
+			latest = t
+		}
+	}
+	if latest == nil {
+		if status != "" {
+			return nil, fmt.Errorf("no recent task with status %s found for project template %d", status, templateID)
+		}
+		return nil, fmt.Errorf("no recent task found for project template %d", templateID)
+	}
+	return latest, nil
+}
+
+// truncateRawOutput keeps at most maxBytes bytes from the end of the output,
+// without splitting a multi-byte character.
+func truncateRawOutput(output string, maxBytes int64) (string, bool) {
+	if int64(len(output)) <= maxBytes {
+		return output, false
+	}
+	start := len(output) - int(maxBytes)
+	for start < len(output) && !utf8.RuneStart(output[start]) {
+		start++
+	}
+	return output[start:], true
+}
+
+func convertTaskResponseToProjectTaskDataSourceModel(projectID int64, response *models.Task, config ProjectTaskDataSourceModel) ProjectTaskDataSourceModel {
+	return ProjectTaskDataSourceModel{
+		ID:                 types.Int64Value(response.ID),
+		ProjectID:          types.Int64Value(projectID),
+		TemplateID:         types.Int64Value(response.TemplateID),
+		Status:             types.StringValue(response.Status),
+		Message:            types.StringValue(response.Message),
+		GitBranch:          types.StringValue(response.GitBranch),
+		Version:            types.StringValue(response.Version),
+		CommitHash:         types.StringValue(response.CommitHash),
+		CommitMessage:      types.StringValue(response.CommitMessage),
+		IncludeRawOutput:   config.IncludeRawOutput,
+		RawOutputMaxBytes:  config.RawOutputMaxBytes,
+		RawOutput:          types.StringNull(),
+		RawOutputTruncated: types.BoolValue(false),
+	}
+}
+
+func (d *projectTaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ProjectTaskDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := config.ProjectID.ValueInt64()
+	var payload *models.Task
+	if !config.ID.IsUnknown() && !config.ID.IsNull() {
+		response, err := d.client.Task.GetProjectProjectIDTasksTaskID(&task.GetProjectProjectIDTasksTaskIDParams{
+			ProjectID: projectID,
+			TaskID:    config.ID.ValueInt64(),
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Task", "Could not read project task", err)...)
+			return
+		}
+		payload = response.Payload
+		if status := config.Status.ValueString(); status != "" && payload.Status != status {
+			resp.Diagnostics.AddError(
+				"Unexpected SemaphoreUI Project Task Status",
+				fmt.Sprintf("Project task %d has status %q, expected %q.", payload.ID, payload.Status, status),
+			)
+			return
+		}
+	} else {
+		latest, err := d.GetLatestTask(projectID, config.TemplateID.ValueInt64(), config.Status.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Task", "", err)...)
+			return
+		}
+		payload = latest
+	}
+	model := convertTaskResponseToProjectTaskDataSourceModel(projectID, payload, config)
+
+	if config.IncludeRawOutput.ValueBool() {
+		response, err := d.client.Task.GetProjectProjectIDTasksTaskIDRawOutput(&task.GetProjectProjectIDTasksTaskIDRawOutputParams{
+			ProjectID: projectID,
+			TaskID:    payload.ID,
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Task Output", "Could not read project task output", err)...)
+			return
+		}
+		maxBytes := int64(defaultRawOutputMaxBytes)
+		if !config.RawOutputMaxBytes.IsNull() && !config.RawOutputMaxBytes.IsUnknown() {
+			maxBytes = config.RawOutputMaxBytes.ValueInt64()
+		}
+		output, truncated := truncateRawOutput(response.Payload, maxBytes)
+		model.RawOutput = types.StringValue(output)
+		model.RawOutputTruncated = types.BoolValue(truncated)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"terraform-provider-semaphoreui/semaphoreui/client/task"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProjectTaskRun starts a task from the template so that the data
+// source has a task to read.
+func testAccProjectTaskRun(templateResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[templateResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", templateResourceName)
+		}
+
+		id, _ := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		projectID, _ := strconv.ParseInt(rs.Primary.Attributes["project_id"], 10, 64)
+
+		_, err := testClient().Task.PostProjectProjectIDTasks(&task.PostProjectProjectIDTasksParams{
+			ProjectID: projectID,
+			Task: task.PostProjectProjectIDTasksBody{
+				TemplateID: id,
+				Message:    "Data source test",
+			},
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not start project task: %s", err.Error())
+		}
+		return nil
+	}
+}
+
+func testAccProjectTaskDataSourceConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "semaphoreui_project_task" "latest" {
+  project_id         = semaphoreui_project.test.id
+  template_id        = semaphoreui_project_template.test.id
+  include_raw_output = true
+}
+
+data "semaphoreui_project_task" "by_id" {
+  project_id           = semaphoreui_project.test.id
+  id                   = data.semaphoreui_project_task.latest.id
+  include_raw_output   = true
+  raw_output_max_bytes = 1
+}
+`, testAccProjectTemplateConfig(nameSuffix, ""))
+}
+
+func TestAcc_ProjectTaskDataSource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, ""),
+				Check:  testAccProjectTaskRun("semaphoreui_project_template.test"),
+			},
+			{
+				Config: testAccProjectTaskDataSourceConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.semaphoreui_project_task.latest", "id"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_task.latest", "template_id", "semaphoreui_project_template.test", "id"),
+					resource.TestCheckResourceAttrSet("data.semaphoreui_project_task.latest", "status"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_task.latest", "message", "Data source test"),
+					resource.TestCheckResourceAttrSet("data.semaphoreui_project_task.latest", "raw_output"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_task.by_id", "id", "data.semaphoreui_project_task.latest", "id"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_task.by_id", "message", "Data source test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/task"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultProjectTaskTimeout = "30m"
+
+	// projectTaskPollInterval is how often a running task is polled for its
+	// status.
+	projectTaskPollInterval = 5 * time.Second
+
+	// projectTaskOutputLines is the number of trailing task output lines
+	// included in the error reported for a failed task.
+	projectTaskOutputLines = 20
+
+	taskStatusSuccess     = "success"
+	taskStatusError       = "error"
+	taskStatusStopped     = "stopped"
+	taskStatusRejected    = "rejected"
+	taskStatusNotExecuted = "not_executed"
+)
+
+// isTaskFinished reports whether a task status is terminal, i.e. the task
+// will not change status anymore.
+func isTaskFinished(status string) bool {
+	switch status {
+	case taskStatusSuccess, taskStatusError, taskStatusStopped, taskStatusRejected, taskStatusNotExecuted:
+		return true
+	}
+	return false
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectTaskResource{}
+	_ resource.ResourceWithConfigure   = &projectTaskResource{}
+	_ resource.ResourceWithImportState = &projectTaskResource{}
+)
+
+func NewProjectTaskResource() resource.Resource {
+	return &projectTaskResource{}
+}
+
+type projectTaskResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *projectTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *projectTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_task"
+}
+
+func (r *projectTaskResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ProjectTaskSchema().GetResource(ctx)
+}
+
+func convertProjectTaskModelToTaskRequest(ctx context.Context, model ProjectTaskModel) task.PostProjectProjectIDTasksBody {
+	request := task.PostProjectProjectIDTasksBody{
+		TemplateID:  model.TemplateID.ValueInt64(),
+		Message:     model.Message.ValueString(),
+		GitBranch:   model.GitBranch.ValueString(),
+		Arguments:   model.Arguments.ValueString(),
+		Environment: model.Environment.ValueString(),
+		Limit:       model.Limit.ValueString(),
+	}
+	params := convertTaskParamsModelToTaskPrams(ctx, &TaskParamsModel{
+		Ansible:   model.Ansible,
+		Terraform: model.Terraform,
+	})
+	request.Params.AnsibleTaskParams = params.Params.AnsibleTaskParams
+	request.Params.TerraformTaskParams = params.Params.TerraformTaskParams
+	// Older SemaphoreUI versions only read the Ansible flags from the top
+	// level of the request.
+	request.Debug = params.Params.Debug
+	request.Diff = params.Params.Diff
+	request.DryRun = params.Params.DryRun
+	return request
+}
+
+func (r *projectTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectTaskModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(plan.Timeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Task Timeout",
+			"Could not parse timeout: "+err.Error(),
+		)
+		return
+	}
+
+	request := convertProjectTaskModelToTaskRequest(ctx, plan)
+	response, err := r.client.Task.PostProjectProjectIDTasks(&task.PostProjectProjectIDTasksParams{
+		ProjectID: plan.ProjectID.ValueInt64(),
+		Task:      request,
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Task", "Could not create project task", err)...)
+		return
+	}
+
+	plan.ID = types.Int64Value(response.Payload.ID)
+	plan.Status = types.StringValue(response.Payload.Status)
+
+	status, err := r.waitForTask(ctx, plan.ProjectID.ValueInt64(), plan.ID.ValueInt64(), timeout)
+	if status != "" {
+		plan.Status = types.StringValue(status)
+	}
+	// The task has been started at this point, so always record it. If it did
+	// not succeed the resource is tainted and the next apply runs a new task.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Waiting For SemaphoreUI Project Task", fmt.Sprintf("Could not wait for project task %d to finish", plan.ID.ValueInt64()), err)...)
+		return
+	}
+	if status != taskStatusSuccess {
+		resp.Diagnostics.AddError(
+			"SemaphoreUI Project Task Failed",
+			fmt.Sprintf("Project task %d finished with status %q.%s", plan.ID.ValueInt64(), status, r.taskOutputTail(plan.ProjectID.ValueInt64(), plan.ID.ValueInt64())),
+		)
+		return
+	}
+}
+
+// waitForTask polls the task until it reaches a terminal status, the timeout
+// expires or the context is cancelled. The last observed status is returned
+// along with any error.
+func (r *projectTaskResource) waitForTask(ctx context.Context, projectID, taskID int64, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(projectTaskPollInterval)
+	defer ticker.Stop()
+
+	var status string
+	for {
+		response, err := r.client.Task.GetProjectProjectIDTasksTaskIDContext(ctx, &task.GetProjectProjectIDTasksTaskIDParams{
+			ProjectID: projectID,
+			TaskID:    taskID,
+		}, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status, fmt.Errorf("task did not finish within %s, last status %q", timeout, status)
+			}
+			return status, err
+		}
+		status = response.Payload.Status
+		if isTaskFinished(status) {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("task did not finish within %s, last status %q", timeout, status)
+		case <-ticker.C:
+		}
+	}
+}
+
+// taskOutputTail returns the last lines of the task output formatted for
+// inclusion in a diagnostic, or an empty string if it cannot be read.
+func (r *projectTaskResource) taskOutputTail(projectID, taskID int64) string {
+	response, err := r.client.Task.GetProjectProjectIDTasksTaskIDOutput(&task.GetProjectProjectIDTasksTaskIDOutputParams{
+		ProjectID: projectID,
+		TaskID:    taskID,
+	}, nil)
+	if err != nil || len(response.Payload) == 0 {
+		return ""
+	}
+
+	output := response.Payload
+	if len(output) > projectTaskOutputLines {
+		output = output[len(output)-projectTaskOutputLines:]
+	}
+	lines := make([]string, 0, len(output))
+	for _, line := range output {
+		lines = append(lines, line.Output)
+	}
+	return "\n\nTask output (last lines):\n" + strings.Join(lines, "\n")
+}
+
+func (r *projectTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectTaskModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Task.GetProjectProjectIDTasksTaskID(&task.GetProjectProjectIDTasksTaskIDParams{
+		ProjectID: state.ProjectID.ValueInt64(),
+		TaskID:    state.ID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		if isNotFound(err) {
+			// Drift: task deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Task", "Could not read project task", err)...)
+		return
+	}
+
+	state.Status = types.StringValue(response.Payload.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only handles `timeout`; every other argument requires a new task.
+// The new timeout is only recorded in the state: the task already ran, so it
+// is not sent to the API.
+func (r *projectTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectTaskModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Status = state.Status
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the task from the Terraform state. Tasks are history in
+// SemaphoreUI and are kept when the resource is destroyed.
+func (r *projectTaskResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *projectTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "task"})
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Task Import ID", "Could not parse import ID", err)...)
+		return
+	}
+
+	response, err := r.client.Task.GetProjectProjectIDTasksTaskID(&task.GetProjectProjectIDTasksTaskIDParams{
+		ProjectID: fields["project"],
+		TaskID:    fields["task"],
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Task", "Could not read project task", err)...)
+		return
+	}
+
+	model := convertTaskResponseToProjectTaskModel(fields["project"], response.Payload)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// convertTaskResponseToProjectTaskModel builds the state of an imported task.
+// The task parameters and triggers are not returned by the API and are left
+// null, so an imported task is only kept if the configuration does not set
+// them and matches the other arguments of the task.
+func convertTaskResponseToProjectTaskModel(projectID int64, response *models.Task) ProjectTaskModel {
+	return ProjectTaskModel{
+		ID:          types.Int64Value(response.ID),
+		ProjectID:   types.Int64Value(projectID),
+		TemplateID:  types.Int64Value(response.TemplateID),
+		Message:     stringOrNull(response.Message),
+		GitBranch:   stringOrNull(response.GitBranch),
+		Arguments:   stringOrNull(response.Arguments),
+		Environment: stringOrNull(response.Environment),
+		Limit:       stringOrNull(response.Limit),
+		Triggers:    types.MapNull(types.StringType),
+		Timeout:     types.StringValue(defaultProjectTaskTimeout),
+		Status:      types.StringValue(response.Status),
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccProjectTaskConfig(nameSuffix string, trigger string, timeout string) string {
+	return fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project_task" "test" {
+  project_id  = semaphoreui_project.test.id
+  template_id = semaphoreui_project_template.test.id
+  message     = "Task-%[2]s"
+  limit       = "localhost"
+  timeout     = "%[4]s"
+  ansible = {
+    tags = ["bootstrap"]
+  }
+  triggers = {
+    run = "%[3]s"
+  }
+}
+`, testAccProjectTemplateConfig(nameSuffix, ""), nameSuffix, trigger, timeout)
+}
+
+func testAccProjectTaskImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("project/%[1]s/task/%[2]s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestAcc_ProjectTaskResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// An invalid timeout is reported at plan time
+			{
+				Config:      testAccProjectTaskConfig(nameSuffix, "1", "5mins"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid duration`),
+			},
+			// The test repository cannot be cloned, so the task is started,
+			// polled to completion and reported as failed.
+			{
+				Config:      testAccProjectTaskConfig(nameSuffix, "1", "5m"),
+				ExpectError: regexp.MustCompile(`finished with status "error"`),
+			},
+			// ImportState testing. The timeout, task parameters and triggers
+			// are not returned by the API.
+			{
+				ResourceName:            "semaphoreui_project_task.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccProjectTaskImportID("semaphoreui_project_task.test"),
+				ImportStateVerifyIgnore: []string{"timeout", "ansible", "triggers"},
+			},
+			// Delete
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceNotExists("semaphoreui_project_task.test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+	"terraform-provider-semaphoreui/internal/stringvalidator"
+)
+
+type ProjectTaskModel struct {
+	ID          types.Int64               `tfsdk:"id"`
+	ProjectID   types.Int64               `tfsdk:"project_id"`
+	TemplateID  types.Int64               `tfsdk:"template_id"`
+	Message     types.String              `tfsdk:"message"`
+	GitBranch   types.String              `tfsdk:"git_branch"`
+	Arguments   types.String              `tfsdk:"arguments"`
+	Environment types.String              `tfsdk:"environment"`
+	Limit       types.String              `tfsdk:"limit"`
+	Ansible     *AnsibleTaskParamsModel   `tfsdk:"ansible"`
+	Terraform   *TerraformTaskParamsModel `tfsdk:"terraform"`
+	Triggers    types.Map                 `tfsdk:"triggers"`
+	Timeout     types.String              `tfsdk:"timeout"`
+	Status      types.String              `tfsdk:"status"`
+}
+
+// projectTaskParamsAttribute reuses a nested attribute ("ansible" or
+// "terraform") of the shared task_params schema. Any change to the parameters
+// of a launched task requires running a new task.
+func projectTaskParamsAttribute(name string) superschema.Attribute {
+	attr := TaskParamsAttribute().(superschema.SingleNestedAttribute).Attributes[name].(superschema.SingleNestedAttribute)
+	attr.Resource.PlanModifiers = []planmodifier.Object{objectplanmodifier.RequiresReplace()}
+	return attr
+}
+
+func ProjectTaskSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project task",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to run a task from a project template and wait for it to finish. Changing any argument other than `timeout` runs a new task; `timeout` only applies while a task runs, so changing it only updates the Terraform state. Destroying the resource only removes it from the Terraform state; the task history is kept in SemaphoreUI. Import is only meant to adopt a task whose `message`, `git_branch`, `arguments`, `environment` and `limit` exactly match the configuration, as any difference runs a new task. SemaphoreUI does not return `ansible`, `terraform` and `triggers`, so setting them on an imported task runs a new task as well.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The task ID.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+			"project_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The project ID that the task belongs to.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"template_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The template ID to run the task from.",
+					Required:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				},
+			},
+			"message": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Commit-style message recorded with the task.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"git_branch": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Override the repository branch checked out for the task.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"arguments": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "JSON-encoded array of extra command-line arguments passed to the task runner (e.g. `jsonencode([\"-vvv\"])`). The template must allow argument overrides.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"environment": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "JSON-encoded object of extra environment variables exposed to the task (e.g. `jsonencode({ HOST = \"web-1\" })`).",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"limit": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Comma-separated list of Ansible hosts to limit the run to (`--limit`).",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"ansible":   projectTaskParamsAttribute("ansible"),
+			"terraform": projectTaskParamsAttribute("terraform"),
+			"triggers": superschema.MapAttribute{
+				Common: &schemaR.MapAttribute{
+					MarkdownDescription: "Arbitrary map of values that, when changed, runs a new task.",
+					ElementType:         types.StringType,
+				},
+				Resource: &schemaR.MapAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+				},
+			},
+			"timeout": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "How long to wait for the task to finish. The timeout only applies while a new task runs: changing it afterwards only updates the Terraform state.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(defaultProjectTaskTimeout),
+					Validators: []validator.String{
+						stringvalidator.Duration(),
+					},
+				},
+			},
+			"status": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The status of the task (e.g. `success`, `error`, `stopped`).",
+				},
+				Resource: &schemaR.StringAttribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+		},
+	}
+}
+
+type ProjectTaskDataSourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	ProjectID          types.Int64  `tfsdk:"project_id"`
+	TemplateID         types.Int64  `tfsdk:"template_id"`
+	Status             types.String `tfsdk:"status"`
+	Message            types.String `tfsdk:"message"`
+	GitBranch          types.String `tfsdk:"git_branch"`
+	Version            types.String `tfsdk:"version"`
+	CommitHash         types.String `tfsdk:"commit_hash"`
+	CommitMessage      types.String `tfsdk:"commit_message"`
+	IncludeRawOutput   types.Bool   `tfsdk:"include_raw_output"`
+	RawOutputMaxBytes  types.Int64  `tfsdk:"raw_output_max_bytes"`
+	RawOutput          types.String `tfsdk:"raw_output"`
+	RawOutputTruncated types.Bool   `tfsdk:"raw_output_truncated"`
+}
+
+func ProjectTaskDataSourceSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The project task",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source allows you to read the result of a task, either by its ID or as the latest task run from a template.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.Int64Attribute{
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: "The task ID.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(
+							path.MatchRoot("id"),
+							path.MatchRoot("template_id"),
+						),
+					},
+				},
+			},
+			"project_id": superschema.Int64Attribute{
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: "The project ID that the task belongs to.",
+					Required:            true,
+				},
+			},
+			"template_id": superschema.Int64Attribute{
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: "The template ID the task was run from. When `id` is not set, the latest task of this template is read.",
+					Optional:            true,
+					Computed:            true,
+				},
+			},
+			"status": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The status of the task (e.g. `success`, `error`, `stopped`). When looking up the latest task of a template, only tasks with this status are considered (e.g. `success` for the last successful build).",
+					Optional:            true,
+					Computed:            true,
+				},
+			},
+			"message": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The message recorded with the task.",
+					Computed:            true,
+				},
+			},
+			"git_branch": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The repository branch the task ran against, if overridden.",
+					Computed:            true,
+				},
+			},
+			"version": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The version produced by a build task, or deployed by a deploy task.",
+					Computed:            true,
+				},
+			},
+			"commit_hash": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The repository commit the task ran against.",
+					Computed:            true,
+				},
+			},
+			"commit_message": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The message of the repository commit the task ran against.",
+					Computed:            true,
+				},
+			},
+			"include_raw_output": superschema.BoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Whether to read the raw task output into `raw_output`. Defaults to `false`.",
+					Optional:            true,
+				},
+			},
+			"raw_output_max_bytes": superschema.Int64Attribute{
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: fmt.Sprintf("The maximum size of `raw_output` in bytes. Longer output is truncated, keeping its end. Defaults to `%d`.", defaultRawOutputMaxBytes),
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+			},
+			"raw_output": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The raw task output. Only set when `include_raw_output` is `true`.",
+					Computed:            true,
+					Sensitive:           true,
+				},
+			},
+			"raw_output_truncated": superschema.BoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Whether `raw_output` was truncated to `raw_output_max_bytes`.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -28,15 +28,15 @@ func (d *projectTemplateDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -54,7 +54,7 @@ func (d *projectTemplateDataSource) GetTemplateByName(projectID int64, name stri
 		ProjectID: projectID,
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read project repositories: %s", err.Error())
+		return nil, fmt.Errorf("could not read project repositories: %w", err)
 	}
 	for _, template := range response.Payload {
 		if template.Name == name {
@@ -78,20 +78,14 @@ func (d *projectTemplateDataSource) Read(ctx context.Context, req datasource.Rea
 			TemplateID: config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Template",
-				"Could not read project template, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Template", "Could not read project template", err)...)
 			return
 		}
 		model = convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &config)
 	} else if !config.Name.IsUnknown() && !config.Name.IsNull() {
 		template, err := d.GetTemplateByName(config.ProjectID.ValueInt64(), config.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Template",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Template", "", err)...)
 			return
 		}
 		model = convertTemplateResponseToProjectTemplateModel(ctx, template, &config)
@@ -18,6 +18,7 @@ var (
 	_ resource.ResourceWithConfigure        = &projectTemplateResource{}
 	_ resource.ResourceWithImportState      = &projectTemplateResource{}
 	_ resource.ResourceWithConfigValidators = &projectTemplateResource{}
+	_ resource.ResourceWithModifyPlan       = &projectTemplateResource{}
 )
 
 func NewProjectTemplateResource() resource.Resource {
@@ -25,7 +26,8 @@ func NewProjectTemplateResource() resource.Resource {
 }
 
 type projectTemplateResource struct {
-	client *apiclient.SemaphoreUI
+	client     *apiclient.SemaphoreUI
+	serverInfo *models.InfoType
 }
 
 func (r *projectTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -33,16 +35,17 @@ func (r *projectTemplateResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
+	r.serverInfo = data.serverInfo
 }
 
 func (r *projectTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -105,15 +108,33 @@ func (r *projectTemplateResource) ConfigValidators(_ context.Context) []resource
 	return []resource.ConfigValidator{playbookRequiredValidator{}}
 }
 
-func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template ProjectTemplateModel) *models.TemplateRequest {
+// projectTemplateReferences are the attributes of a template that refer to
+// other objects of its project.
+var projectTemplateReferences = []projectReference{
+	{path: path.Root("inventory_id"), kind: "inventory"},
+	{path: path.Root("repository_id"), kind: "repository"},
+	{path: path.Root("environment_id"), kind: "environment"},
+	{path: path.Root("view_id"), kind: "view"},
+	{path: path.Root("deploy").AtName("build_template_id"), kind: "template"},
+}
+
+func (r *projectTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateProjectReferences(ctx, r.client, req, projectTemplateReferences)...)
+}
+
+// environmentIDsMinServerVersion is the first SemaphoreUI version that
+// supports multiple environments per template.
+const environmentIDsMinServerVersion = "2.16.0"
+
+func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template ProjectTemplateModel, environmentIDs bool) *models.TemplateRequest {
 	// SemaphoreUI v2.16+ replaced the singular environment_id with an
 	// environment_ids array. The legacy environment_id is still accepted on
 	// create but is read back as 0; only environment_ids round-trips on GET.
+	// Older servers only receive the legacy field.
 	envID := template.EnvironmentID.ValueInt64()
 	model := models.TemplateRequest{
 		ProjectID:               template.ProjectID.ValueInt64(),
 		EnvironmentID:           envID,
-		EnvironmentIds:          []int64{envID},
 		InventoryID:             template.InventoryID.ValueInt64(),
 		RepositoryID:            template.RepositoryID.ValueInt64(),
 		App:                     template.App.ValueString(),
@@ -122,6 +143,9 @@ func convertProjectTemplateModelToTemplateRequest(ctx context.Context, template
 		AllowOverrideArgsInTask: template.AllowOverrideArgsInTask.ValueBool(),
 		SuppressSuccessAlerts:   template.SuppressSuccessAlerts.ValueBool(),
 	}
+	if environmentIDs {
+		model.EnvironmentIds = []int64{envID}
+	}
 	if !template.ID.IsNull() && !template.ID.IsUnknown() {
 		model.ID = template.ID.ValueInt64()
 	}
@@ -360,13 +384,10 @@ func (r *projectTemplateResource) Create(ctx context.Context, req resource.Creat
 
 	create, err := r.client.Template.PostProjectProjectIDTemplates(&template.PostProjectProjectIDTemplatesParams{
 		ProjectID: plan.ProjectID.ValueInt64(),
-		Template:  convertProjectTemplateModelToTemplateRequest(ctx, plan),
+		Template:  convertProjectTemplateModelToTemplateRequest(ctx, plan, serverVersionAtLeast(r.serverInfo, environmentIDsMinServerVersion)),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project Template",
-			"Could not create project template, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project Template", "Could not create project template", err)...)
 		return
 	}
 
@@ -376,10 +397,7 @@ func (r *projectTemplateResource) Create(ctx context.Context, req resource.Creat
 		TemplateID: create.Payload.ID,
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Template",
-			"Could not read project template, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Template", "Could not read project template", err)...)
 		return
 	}
 	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &plan)
@@ -405,10 +423,12 @@ func (r *projectTemplateResource) Read(ctx context.Context, req resource.ReadReq
 		TemplateID: state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Template",
-			"Could not read project template, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project template deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Template", "Could not read project template", err)...)
 		return
 	}
 	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &state)
@@ -432,13 +452,10 @@ func (r *projectTemplateResource) Update(ctx context.Context, req resource.Updat
 	_, err := r.client.Template.PutProjectProjectIDTemplatesTemplateID(&template.PutProjectProjectIDTemplatesTemplateIDParams{
 		ProjectID:  plan.ProjectID.ValueInt64(),
 		TemplateID: plan.ID.ValueInt64(),
-		Template:   convertProjectTemplateModelToTemplateRequest(ctx, plan),
+		Template:   convertProjectTemplateModelToTemplateRequest(ctx, plan, serverVersionAtLeast(r.serverInfo, environmentIDsMinServerVersion)),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project Template",
-			"Could not update project template, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project Template", "Could not update project template", err)...)
 		return
 	}
 
@@ -447,10 +464,7 @@ func (r *projectTemplateResource) Update(ctx context.Context, req resource.Updat
 		TemplateID: plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Template",
-			"Could not read project template, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Template", "Could not read project template", err)...)
 		return
 	}
 	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &plan)
@@ -473,22 +487,16 @@ func (r *projectTemplateResource) Delete(ctx context.Context, req resource.Delet
 		ProjectID:  state.ProjectID.ValueInt64(),
 		TemplateID: state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Project Template",
-			"Could not delete project template, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project Template", "Could not delete project template", err)...)
 		return
 	}
 }
 
 func (r *projectTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "template"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "template"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project Template Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project Template Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -497,10 +505,7 @@ func (r *projectTemplateResource) ImportState(ctx context.Context, req resource.
 		TemplateID: fields["template"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project Template",
-			"Could not read project template, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Template", "Could not read project template", err)...)
 		return
 	}
 	model := convertTemplateResponseToProjectTemplateModel(ctx, response.Payload, &ProjectTemplateModel{
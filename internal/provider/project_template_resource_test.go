@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
 	"strconv"
 	"terraform-provider-semaphoreui/semaphoreui/client/template"
 	"testing"
@@ -164,6 +165,10 @@ func testAccProjectTemplateImportID(n string) resource.ImportStateIdFunc {
 	}
 }
 
+func testAccProjectTemplateImportNameID(nameSuffix string) string {
+	return fmt.Sprintf("project/test-%[1]s/template/Test %[1]s", nameSuffix)
+}
+
 func TestAcc_ProjectTemplateResource_basic(t *testing.T) {
 	nameSuffix := acctest.RandString(8)
 	resource.Test(t, resource.TestCase{
@@ -202,6 +207,13 @@ func TestAcc_ProjectTemplateResource_basic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: testAccProjectTemplateImportID("semaphoreui_project_template.test"),
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "semaphoreui_project_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccProjectTemplateImportNameID(nameSuffix),
+			},
 			// Update testing
 			{
 				Config: testAccProjectTemplateConfig(nameSuffix, `
@@ -783,3 +795,78 @@ func TestAcc_ProjectTemplateResource_taskParamsTerraform(t *testing.T) {
 		},
 	})
 }
+
+// TestAcc_ProjectTemplateResource_disappears verifies that a project template deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectTemplateResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplateConfig(nameSuffix, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectTemplateExists("semaphoreui_project_template.test", ""),
+					testAccDeleteOutOfBand("semaphoreui_project_template.test", func(attributes map[string]string) error {
+						_, err := testClient().Template.DeleteProjectProjectIDTemplatesTemplateID(&template.DeleteProjectProjectIDTemplatesTemplateIDParams{
+							ProjectID:  testAccInt64Attribute(attributes, "project_id"),
+							TemplateID: testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestAcc_ProjectTemplateResource_foreignInventory verifies that an inventory
+// of another project is rejected at plan time.
+func TestAcc_ProjectTemplateResource_foreignInventory(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	otherProjectConfig := fmt.Sprintf(`
+%[1]s
+resource "semaphoreui_project" "other" {
+  name = "other-%[2]s"
+}
+
+resource "semaphoreui_project_key" "other" {
+  project_id = semaphoreui_project.other.id
+  name       = "None-%[2]s"
+  none       = {}
+}
+
+resource "semaphoreui_project_inventory" "other" {
+  project_id = semaphoreui_project.other.id
+  name       = "Inventory-%[2]s"
+  ssh_key_id = semaphoreui_project_key.other.id
+  static = {
+    inventory = "localhost"
+  }
+}
+`, testAccProjectTemplateDependencyConfig(nameSuffix), nameSuffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: otherProjectConfig,
+			},
+			{
+				Config: otherProjectConfig + `
+resource "semaphoreui_project_template" "test" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.other.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Test"
+  playbook       = "playbook.yml"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No inventory with ID \d+ exists in project \d+`),
+			},
+		},
+	})
+}
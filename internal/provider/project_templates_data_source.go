@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/template"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectTemplatesDataSource{}
+)
+
+func NewProjectTemplatesDataSource() datasource.DataSource {
+	return &projectTemplatesDataSource{}
+}
+
+type projectTemplatesDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_templates"
+}
+
+type projectTemplatesDataSourceModel struct {
+	ProjectID types.Int64            `tfsdk:"project_id"`
+	NameRegex types.String           `tfsdk:"name_regex"`
+	App       types.String           `tfsdk:"app"`
+	Type      types.String           `tfsdk:"type"`
+	ViewID    types.Int64            `tfsdk:"view_id"`
+	Templates []ProjectTemplateModel `tfsdk:"templates"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectTemplatesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	templateAttributes := readOnlyAttributes(ProjectTemplateSchema().GetDataSource(ctx).Attributes)
+	templateAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The template ID.",
+		Computed:            true,
+	}
+	templateAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The display name of the template.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Templates of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("templates"),
+			"name_regex": nameRegexAttribute("templates", "name"),
+			"app": schema.StringAttribute{
+				MarkdownDescription: "Only list the templates of this application, e.g. `ansible` or `terraform`.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the templates of this type: `task`, `build` or `deploy`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("task", "build", "deploy"),
+				},
+			},
+			"view_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list the templates of this view.",
+				Optional:            true,
+			},
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the templates that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: templateAttributes,
+				},
+			},
+		},
+	}
+}
+
+// templateType returns the type of a template as named by the type filter.
+func templateType(t *models.Template) string {
+	if t.Type == models.TemplateTypeEmpty {
+		return "task"
+	}
+	return t.Type
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectTemplatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Template.GetProjectProjectIDTemplates(&template.GetProjectProjectIDTemplatesParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Templates", "Could not read project templates", err)...)
+		return
+	}
+
+	config.Templates = []ProjectTemplateModel{}
+	for _, t := range response.Payload {
+		if !matchesName(t.Name) ||
+			(!config.App.IsNull() && t.App != config.App.ValueString()) ||
+			(!config.Type.IsNull() && templateType(t) != config.Type.ValueString()) ||
+			(!config.ViewID.IsNull() && t.ViewID != config.ViewID.ValueInt64()) {
+			continue
+		}
+		config.Templates = append(config.Templates, convertTemplateResponseToProjectTemplateModel(ctx, t, &ProjectTemplateModel{
+			SurveyVars: types.ListNull(ProjectTemplateSurveyVarType),
+			Vaults:     types.ListNull(ProjectTemplateVaultType),
+		}))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
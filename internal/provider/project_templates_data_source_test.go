@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectTemplatesDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Templates DS Project"
+}
+
+resource "semaphoreui_project_key" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "None"
+  none       = {}
+}
+
+resource "semaphoreui_project_repository" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Repo"
+  url        = "/path/to/repo"
+  branch     = ""
+  ssh_key_id = semaphoreui_project_key.test.id
+}
+
+resource "semaphoreui_project_inventory" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Inventory"
+  ssh_key_id = semaphoreui_project_key.test.id
+  file = {
+    path          = "path/to/inventory"
+    repository_id = semaphoreui_project_repository.test.id
+  }
+}
+
+resource "semaphoreui_project_environment" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Env"
+}
+
+resource "semaphoreui_project_view" "test" {
+  project_id = semaphoreui_project.test.id
+  title      = "Deploy"
+  position   = 1
+}
+
+resource "semaphoreui_project_template" "ansible" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.test.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Ansible"
+  playbook       = "playbook.yml"
+  view_id        = semaphoreui_project_view.test.id
+}
+
+resource "semaphoreui_project_template" "bash" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.test.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Bash"
+  app            = "bash"
+  playbook       = "script.sh"
+  view_id        = semaphoreui_project_view.test.id
+}
+
+resource "semaphoreui_project_template" "build" {
+  project_id     = semaphoreui_project.test.id
+  environment_id = semaphoreui_project_environment.test.id
+  inventory_id   = semaphoreui_project_inventory.test.id
+  repository_id  = semaphoreui_project_repository.test.id
+  name           = "Build"
+  playbook       = "build.yml"
+  build          = {}
+}
+
+data "semaphoreui_project_templates" "view" {
+  project_id = semaphoreui_project.test.id
+  view_id    = semaphoreui_project_view.test.id
+  depends_on = [semaphoreui_project_template.ansible, semaphoreui_project_template.bash, semaphoreui_project_template.build]
+}
+
+data "semaphoreui_project_templates" "test" {
+  project_id = semaphoreui_project.test.id
+  app        = "ansible"
+  type       = "task"
+  depends_on = [semaphoreui_project_template.ansible, semaphoreui_project_template.bash, semaphoreui_project_template.build]
+}`
+}
+
+func TestAcc_ProjectTemplatesDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTemplatesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_templates.view", "templates.#", "2"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_templates.test", "templates.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_templates.test", "templates.0.name", "Ansible"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_templates.test", "templates.0.playbook", "playbook.yml"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_project_templates.test", "templates.0.view_id", "semaphoreui_project_view.test", "id"),
+				),
+			},
+		},
+	})
+}
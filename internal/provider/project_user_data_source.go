@@ -28,15 +28,15 @@ func (d *projectUserDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -52,7 +52,7 @@ func (d *projectUserDataSource) Schema(ctx context.Context, _ datasource.SchemaR
 func (d *projectUserDataSource) getProjectUserModelFromAPI(projectId types.Int64, userId types.Int64) (*ProjectUserModel, error) {
 	payload, err := d.client.Project.GetProjectProjectIDUsers(&project.GetProjectProjectIDUsersParams{ProjectID: projectId.ValueInt64()}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Users for project ID %d: %s", projectId.ValueInt64(), err.Error())
+		return nil, fmt.Errorf("could not read Users for project ID %d: %w", projectId.ValueInt64(), err)
 	}
 
 	for _, projectUser := range payload.Payload {
@@ -78,10 +78,7 @@ func (d *projectUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	state, err := d.getProjectUserModelFromAPI(config.ProjectID, config.UserID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project Users",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
 		return
 	}
 
@@ -29,16 +29,16 @@ func (r *projectUserResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,7 +52,7 @@ func (r *projectUserResource) Schema(ctx context.Context, _ resource.SchemaReque
 func (r *projectUserResource) getProjectUserModelFromAPI(projectId types.Int64, userId types.Int64) (*ProjectUserModel, error) {
 	payload, err := r.client.Project.GetProjectProjectIDUsers(&project.GetProjectProjectIDUsersParams{ProjectID: projectId.ValueInt64()}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Users for project ID %d: %s", projectId.ValueInt64(), err.Error())
+		return nil, fmt.Errorf("could not read Users for project ID %d: %w", projectId.ValueInt64(), err)
 	}
 
 	for _, projectUser := range payload.Payload {
@@ -66,7 +66,7 @@ func (r *projectUserResource) getProjectUserModelFromAPI(projectId types.Int64,
 			}, nil
 		}
 	}
-	return nil, fmt.Errorf("user with ID %d not found in project with ID %d", userId.ValueInt64(), projectId.ValueInt64())
+	return nil, fmt.Errorf("user with ID %d %w in project with ID %d", userId.ValueInt64(), errNotFound, projectId.ValueInt64())
 }
 
 func (r *projectUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -87,20 +87,14 @@ func (r *projectUserResource) Create(ctx context.Context, req resource.CreateReq
 			},
 		}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project User",
-			"Could not create project user, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project User", "Could not create project user", err)...)
 		return
 	}
 
 	// Fetch updated values as PostProjectProjectIDUsers does not return updated projectUser
 	user, err := r.getProjectUserModelFromAPI(plan.ProjectID, plan.UserID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project Users",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
 		return
 	}
 
@@ -123,10 +117,12 @@ func (r *projectUserResource) Read(ctx context.Context, req resource.ReadRequest
 	// Get refreshed value from API
 	user, err := r.getProjectUserModelFromAPI(state.ProjectID, state.UserID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project Users",
-			err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project user deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
 		return
 	}
 
@@ -156,20 +152,14 @@ func (r *projectUserResource) Update(ctx context.Context, req resource.UpdateReq
 			},
 		}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Semaphore Project User",
-			"Could not update project user, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating Semaphore Project User", "Could not update project user", err)...)
 		return
 	}
 
 	// Fetch updated values as PutProjectProjectIDUsersUserID does not return updated projectUser
 	user, err := r.getProjectUserModelFromAPI(plan.ProjectID, plan.UserID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Project Users",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Project Users", "", err)...)
 		return
 	}
 
@@ -193,31 +183,22 @@ func (r *projectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 		ProjectID: state.ProjectID.ValueInt64(),
 		UserID:    state.UserID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing Semaphore Project User",
-			"Could not remove project user, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing Semaphore Project User", "Could not remove project user", err)...)
 		return
 	}
 }
 
 func (r *projectUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "user"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "user"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid ProjectUser Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid ProjectUser Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
 	user, err := r.getProjectUserModelFromAPI(types.Int64Value(fields["project"]), types.Int64Value(fields["user"]))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Importing Semaphore Project User",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Importing Semaphore Project User", "", err)...)
 		return
 	}
 
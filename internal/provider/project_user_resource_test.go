@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -81,3 +82,28 @@ func TestAcc_ProjectUserResource(t *testing.T) {
 		},
 	})
 }
+
+// TestAcc_ProjectUserResource_disappears verifies that a project user deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectUserResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectUserConfig(nameSuffix, "guest"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteOutOfBand("semaphoreui_project_user.test_test", func(attributes map[string]string) error {
+						_, err := testClient().Project.DeleteProjectProjectIDUsersUserID(&project.DeleteProjectProjectIDUsersUserIDParams{
+							ProjectID: testAccInt64Attribute(attributes, "project_id"),
+							UserID:    testAccInt64Attribute(attributes, "user_id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -27,15 +27,15 @@ func (d *projectViewDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -53,7 +53,7 @@ func (d *projectViewDataSource) GetViewModelByTitle(projectID int64, title types
 		ProjectID: projectID,
 	}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Views: %s", err.Error())
+		return nil, fmt.Errorf("could not read Views: %w", err)
 	}
 
 	for _, view := range payload.Payload {
@@ -79,20 +79,14 @@ func (d *projectViewDataSource) Read(ctx context.Context, req datasource.ReadReq
 			ViewID:    config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project View",
-				"Could not read project view, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project View", "Could not read project view", err)...)
 			return
 		}
 		model = convertViewResponseToProjectViewModel(response.Payload)
 	} else if !config.Title.IsNull() && !config.Title.IsUnknown() {
 		view, err := d.GetViewModelByTitle(config.ProjectID.ValueInt64(), config.Title)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project View",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project View", "", err)...)
 			return
 		}
 		model = *view
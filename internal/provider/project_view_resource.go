@@ -29,16 +29,16 @@ func (r *projectViewResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *projectViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,10 +82,7 @@ func (r *projectViewResource) Create(ctx context.Context, req resource.CreateReq
 		View:      convertProjectViewModelToView(plan),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Project View",
-			"Could not create project view, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Project View", "Could not create project view", err)...)
 		return
 	}
 	model := convertViewResponseToProjectViewModel(response.Payload)
@@ -110,10 +107,12 @@ func (r *projectViewResource) Read(ctx context.Context, req resource.ReadRequest
 		ViewID:    state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project View",
-			"Could not read project view, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: project view deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project View", "Could not read project view", err)...)
 		return
 	}
 	model := convertViewResponseToProjectViewModel(response.Payload)
@@ -144,10 +143,7 @@ func (r *projectViewResource) Update(ctx context.Context, req resource.UpdateReq
 		},
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Project View",
-			"Could not update project view, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Project View", "Could not update project view", err)...)
 		return
 	}
 
@@ -156,10 +152,7 @@ func (r *projectViewResource) Update(ctx context.Context, req resource.UpdateReq
 		ViewID:    plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project View",
-			"Could not read project view, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project View", "Could not read project view", err)...)
 		return
 	}
 	model := convertViewResponseToProjectViewModel(response.Payload)
@@ -182,22 +175,16 @@ func (r *projectViewResource) Delete(ctx context.Context, req resource.DeleteReq
 		ProjectID: state.ProjectID.ValueInt64(),
 		ViewID:    state.ID.ValueInt64(),
 	}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Project View",
-			"Could not remove project view, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Project View", "Could not remove project view", err)...)
 		return
 	}
 }
 
 func (r *projectViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"project", "view"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"project", "view"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project View Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Project View Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -206,10 +193,7 @@ func (r *projectViewResource) ImportState(ctx context.Context, req resource.Impo
 		ViewID:    fields["view"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Project View",
-			"Could not read project view, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project View", "Could not read project view", err)...)
 		return
 	}
 	model := convertViewResponseToProjectViewModel(response.Payload)
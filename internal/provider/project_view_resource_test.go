@@ -117,3 +117,29 @@ resource "semaphoreui_project" "test" {
 		},
 	})
 }
+
+// TestAcc_ProjectViewResource_disappears verifies that a project view deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_ProjectViewResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectViewConfig(fmt.Sprintf("Test %s", nameSuffix), 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccProjectViewExists("semaphoreui_project_view.test"),
+					testAccDeleteOutOfBand("semaphoreui_project_view.test", func(attributes map[string]string) error {
+						_, err := testClient().Project.DeleteProjectProjectIDViewsViewID(&project.DeleteProjectProjectIDViewsViewIDParams{
+							ProjectID: testAccInt64Attribute(attributes, "project_id"),
+							ViewID:    testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/project"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectViewsDataSource{}
+)
+
+func NewProjectViewsDataSource() datasource.DataSource {
+	return &projectViewsDataSource{}
+}
+
+type projectViewsDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *projectViewsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *projectViewsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_views"
+}
+
+type projectViewsDataSourceModel struct {
+	ProjectID types.Int64        `tfsdk:"project_id"`
+	NameRegex types.String       `tfsdk:"name_regex"`
+	Views     []ProjectViewModel `tfsdk:"views"`
+}
+
+// Schema defines the schema for the data source.
+func (d *projectViewsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	viewAttributes := readOnlyAttributes(ProjectViewSchema().GetDataSource(ctx).Attributes)
+	viewAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The view ID.",
+		Computed:            true,
+	}
+	viewAttributes["title"] = schema.StringAttribute{
+		MarkdownDescription: "Title of the view.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of the Views of a SemaphoreUI Project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": listProjectIDAttribute("views"),
+			"name_regex": nameRegexAttribute("views", "title"),
+			"views": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the views that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: viewAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectViewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectViewsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Project.GetProjectProjectIDViews(&project.GetProjectProjectIDViewsParams{
+		ProjectID: config.ProjectID.ValueInt64(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Views", "Could not read project views", err)...)
+		return
+	}
+
+	config.Views = []ProjectViewModel{}
+	for _, view := range response.Payload {
+		if !matchesName(view.Title) {
+			continue
+		}
+		config.Views = append(config.Views, convertViewResponseToProjectViewModel(view))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
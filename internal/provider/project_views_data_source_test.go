@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccProjectViewsDataSourceConfig() string {
+	return `
+resource "semaphoreui_project" "test" {
+  name = "Views DS Project"
+}
+
+resource "semaphoreui_project_view" "build" {
+  project_id = semaphoreui_project.test.id
+  title      = "Build"
+  position   = 1
+}
+
+resource "semaphoreui_project_view" "deploy" {
+  project_id = semaphoreui_project.test.id
+  title      = "Deploy"
+  position   = 2
+}
+
+data "semaphoreui_project_views" "test" {
+  project_id = semaphoreui_project.test.id
+  name_regex = "^Dep"
+  depends_on = [semaphoreui_project_view.build, semaphoreui_project_view.deploy]
+}`
+}
+
+func TestAcc_ProjectViewsDataSource_filter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectViewsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_project_views.test", "views.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_views.test", "views.0.title", "Deploy"),
+					resource.TestCheckResourceAttr("data.semaphoreui_project_views.test", "views.0.position", "2"),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/project"
@@ -29,15 +28,15 @@ func (d *projectsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -80,10 +79,7 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	response, err := d.client.Project.GetProjects(&project.GetProjectsParams{}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore Projects",
-			fmt.Sprintf("Could not read projects: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore Projects", "Could not read projects", err)...)
 		return
 	}
 
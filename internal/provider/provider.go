@@ -2,26 +2,31 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
-	"net/http"
+	"encoding/json"
 	"net/url"
 	"os"
 	"strconv"
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"time"
 
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = &SemaphoreUIProvider{}
 var _ provider.ProviderWithFunctions = &SemaphoreUIProvider{}
+var _ provider.ProviderWithEphemeralResources = &SemaphoreUIProvider{}
 
 // SemaphoreUIProvider defines the provider implementation.
 type SemaphoreUIProvider struct {
@@ -33,9 +38,22 @@ type SemaphoreUIProvider struct {
 
 // SemaphoreUIProviderModel describes the provider data model.
 type SemaphoreUIProviderModel struct {
-	ApiToken      types.String `tfsdk:"api_token"`
-	TlsSkipVerify types.Bool   `tfsdk:"tls_skip_verify"`
-	ApiBaseUrl    types.String `tfsdk:"api_base_url"`
+	ApiToken       types.String `tfsdk:"api_token"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	MintAPIToken   types.Bool   `tfsdk:"mint_api_token"`
+	TlsSkipVerify  types.Bool   `tfsdk:"tls_skip_verify"`
+	ApiBaseUrl     types.String `tfsdk:"api_base_url"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait   types.String `tfsdk:"retry_max_wait"`
+	RetryUnsafe    types.Bool   `tfsdk:"retry_unsafe_methods"`
+	CACertPEM      types.String `tfsdk:"ca_cert_pem"`
+	CACertFile     types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM  types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM   types.String `tfsdk:"client_key_pem"`
+	HTTPProxy      types.String `tfsdk:"http_proxy"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	Headers        types.Map    `tfsdk:"headers"`
 }
 
 func (p *SemaphoreUIProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -58,13 +76,36 @@ fetch("/api/user/tokens", {
 }).then(res => res.json()).then(data => console.log("api_token = " + data.id));
 ` + "```" + `
 The token will be printed in the console. This token will grant the same level of access as the logged in user. Copy the token value and use it to configure the provider. The token is sensitive and should be treated as a secret. It is recommended to use the ` + "`SEMAPHOREUI_API_TOKEN`" + ` environment variable to configure the provider.
+
+Once the provider is configured, further tokens (e.g. for CI systems) can be managed as code with the ` + "`semaphoreui_user_api_token`" + ` resource.
+
+## Username and Password
+Instead of an API token, the provider can log in with the ` + "`username`" + ` and ` + "`password`" + ` of a SemaphoreUI user, e.g. the admin user created when SemaphoreUI is installed. The provider then uses the session of that login for all requests. This allows a fresh SemaphoreUI instance to be set up from Terraform alone, for example by creating an API token with the ` + "`semaphoreui_user_api_token`" + ` resource. It is recommended to use the ` + "`SEMAPHOREUI_USERNAME`" + ` and ` + "`SEMAPHOREUI_PASSWORD`" + ` environment variables to configure the credentials.
+
+The provider logs out when Terraform stops it at the end of the run. With ` + "`mint_api_token`" + `, the provider instead creates an API token right after logging in, uses it for all requests and ends the session. The token is expired when Terraform stops the provider. If the provider is killed before, the token is left behind, and can be expired in the SemaphoreUI user settings.
+
+## Logging
+With ` + "`TF_LOG=DEBUG`" + `, the provider logs every SemaphoreUI API request in the ` + "`semaphoreui`" + ` subsystem, with its method, path, status, duration and body. Key secrets, environment secrets, passwords, runner private keys and tokens are redacted. Every request carries an ` + "`X-Request-Id`" + ` header, and errors include the request ID, so that failures can be correlated with the logs of the SemaphoreUI server or of a reverse proxy.
 `,
 		Attributes: map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "SemaphoreUI API token. This can also be defined by the `SEMAPHOREUI_API_TOKEN` environment variable.",
+				MarkdownDescription: "SemaphoreUI API token. This can also be defined by the `SEMAPHOREUI_API_TOKEN` environment variable. Either an API token or a username and password must be set.",
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username (or email) to log in to SemaphoreUI with. This can also be defined by the `SEMAPHOREUI_USERNAME` environment variable. Ignored when an API token is set.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password to log in to SemaphoreUI with. This can also be defined by the `SEMAPHOREUI_PASSWORD` environment variable. Ignored when an API token is set.",
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"mint_api_token": schema.BoolAttribute{
+				MarkdownDescription: "Create an API token for the run after logging in with the username and password, and use it instead of the session. The token is expired when the provider stops. This can also be defined by the `SEMAPHOREUI_MINT_API_TOKEN` environment variable. Default: `false`.",
+				Optional:            true,
+			},
 			"api_base_url": schema.StringAttribute{
 				MarkdownDescription: "SemaphoreUI API base URL. This can also be defined by the `SEMAPHOREUI_API_BASE_URL` environment variable. Default: `http://localhost:3000/api`.",
 				Optional:            true,
@@ -73,6 +114,52 @@ The token will be printed in the console. This token will grant the same level o
 				MarkdownDescription: "Skip TLS verification for the SemaphoreUI API when using https. This can also be defined by the `SEMAPHOREUI_TLS_SKIP_VERIFY` environment variable.  Default: `false`.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a read request is retried after a connection error, a `429` or a `5xx` response, with exponential backoff. Requests that change data are only retried with `retry_unsafe_methods`. `0` disables retries. This can also be defined by the `SEMAPHOREUI_MAX_RETRIES` environment variable. Default: `" + strconv.Itoa(defaultMaxRetries) + "`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait between retries, as a Go duration (e.g. `30s`, `2m`). Also caps the delay requested by a `Retry-After` header. This can also be defined by the `SEMAPHOREUI_RETRY_MAX_WAIT` environment variable. Default: `" + defaultRetryMaxWait.String() + "`.",
+				Optional:            true,
+			},
+			"retry_unsafe_methods": schema.BoolAttribute{
+				MarkdownDescription: "Also retry requests that change data (`POST`, `PUT` and `DELETE`) like read requests. SemaphoreUI may have processed a request that failed, so a retry can e.g. create an object twice or fail because it already exists. This can also be defined by the `SEMAPHOREUI_RETRY_UNSAFE_METHODS` environment variable. Default: `false`.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust, in addition to the system CAs, when verifying the TLS certificate of the SemaphoreUI API. This can also be defined by the `SEMAPHOREUI_CA_CERT_PEM` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded CA certificates to trust, in addition to the system CAs, when verifying the TLS certificate of the SemaphoreUI API. This can also be defined by the `SEMAPHOREUI_CA_CERT_FILE` environment variable.",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS authentication. Requires `client_key_pem`. This can also be defined by the `SEMAPHOREUI_CLIENT_CERT_PEM` environment variable.",
+				Optional:            true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate for mutual TLS authentication. Requires `client_cert_pem`. This can also be defined by the `SEMAPHOREUI_CLIENT_KEY_PEM` environment variable.",
+				Sensitive:           true,
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send requests to the SemaphoreUI API through (e.g. `http://proxy.example.com:3128`). This can also be defined by the `SEMAPHOREUI_HTTP_PROXY` environment variable. Default: the proxy of the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time for each attempt of a request to the SemaphoreUI API, including reading the response, as a Go duration (e.g. `30s`, `2m`). An attempt that times out is retried like a connection error. The waits between retries are not counted, so a read request can take up to `max_retries + 1` times the timeout, plus the waits of up to `retry_max_wait`. `0` disables the timeout. This can also be defined by the `SEMAPHOREUI_REQUEST_TIMEOUT` environment variable. Default: `0`.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers to send with every request to the SemaphoreUI API, e.g. for an authenticating reverse proxy. This can also be defined by the `SEMAPHOREUI_HEADERS` environment variable as a JSON object.",
+				ElementType:         types.StringType,
+				Sensitive:           true,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -95,12 +182,30 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
-	if config.ApiToken.IsUnknown() {
+	if config.Username.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Unknown SemaphoreUI API Token",
-			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI API token. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_API_TOKEN environment variable.",
+			path.Root("username"),
+			"Unknown SemaphoreUI Username",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI username. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_USERNAME environment variable.",
+		)
+	}
+
+	if config.Password.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Unknown SemaphoreUI Password",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI password. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_PASSWORD environment variable.",
+		)
+	}
+
+	if config.MintAPIToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mint_api_token"),
+			"Unknown SemaphoreUI Mint API Token",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI mint API token setting. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_MINT_API_TOKEN environment variable.",
 		)
 	}
 
@@ -113,6 +218,96 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown SemaphoreUI Max Retries",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI max retries. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_MAX_RETRIES environment variable.",
+		)
+	}
+
+	if config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown SemaphoreUI Retry Max Wait",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI retry max wait. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_RETRY_MAX_WAIT environment variable.",
+		)
+	}
+
+	if config.RetryUnsafe.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_unsafe_methods"),
+			"Unknown SemaphoreUI Retry Unsafe Methods",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI retry unsafe methods setting. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_RETRY_UNSAFE_METHODS environment variable.",
+		)
+	}
+
+	if config.CACertPEM.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Unknown SemaphoreUI CA Certificate PEM",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI CA certificate PEM. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_CA_CERT_PEM environment variable.",
+		)
+	}
+
+	if config.CACertFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Unknown SemaphoreUI CA Certificate File",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI CA certificate file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_CA_CERT_FILE environment variable.",
+		)
+	}
+
+	if config.ClientCertPEM.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cert_pem"),
+			"Unknown SemaphoreUI Client Certificate PEM",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI client certificate PEM. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_CLIENT_CERT_PEM environment variable.",
+		)
+	}
+
+	if config.ClientKeyPEM.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_key_pem"),
+			"Unknown SemaphoreUI Client Key PEM",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI client key PEM. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_CLIENT_KEY_PEM environment variable.",
+		)
+	}
+
+	if config.HTTPProxy.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("http_proxy"),
+			"Unknown SemaphoreUI HTTP Proxy",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI HTTP proxy. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_HTTP_PROXY environment variable.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown SemaphoreUI Request Timeout",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI request timeout. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_REQUEST_TIMEOUT environment variable.",
+		)
+	}
+
+	if config.Headers.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("headers"),
+			"Unknown SemaphoreUI Headers",
+			"The provider cannot create the SemaphoreUI API client as there is an unknown configuration value for the SemaphoreUI headers. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEMAPHOREUI_HEADERS environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -120,18 +315,84 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 	apiToken := os.Getenv("SEMAPHOREUI_API_TOKEN")
+	username := os.Getenv("SEMAPHOREUI_USERNAME")
+	password := os.Getenv("SEMAPHOREUI_PASSWORD")
+	mintAPIToken := os.Getenv("SEMAPHOREUI_MINT_API_TOKEN")
 	apiBaseUrl := os.Getenv("SEMAPHOREUI_API_BASE_URL")
 	tlsSkipVerify := os.Getenv("SEMAPHOREUI_TLS_SKIP_VERIFY")
+	maxRetries := os.Getenv("SEMAPHOREUI_MAX_RETRIES")
+	retryMaxWait := os.Getenv("SEMAPHOREUI_RETRY_MAX_WAIT")
+	retryUnsafe := os.Getenv("SEMAPHOREUI_RETRY_UNSAFE_METHODS")
+	caCertPEM := os.Getenv("SEMAPHOREUI_CA_CERT_PEM")
+	caCertFile := os.Getenv("SEMAPHOREUI_CA_CERT_FILE")
+	clientCertPEM := os.Getenv("SEMAPHOREUI_CLIENT_CERT_PEM")
+	clientKeyPEM := os.Getenv("SEMAPHOREUI_CLIENT_KEY_PEM")
+	httpProxy := os.Getenv("SEMAPHOREUI_HTTP_PROXY")
+	requestTimeout := os.Getenv("SEMAPHOREUI_REQUEST_TIMEOUT")
+	headersJSON := os.Getenv("SEMAPHOREUI_HEADERS")
 
 	if !config.ApiBaseUrl.IsNull() {
 		apiBaseUrl = config.ApiBaseUrl.ValueString()
 	}
 	if !config.ApiToken.IsNull() {
 		apiToken = config.ApiToken.ValueString()
+	} else if !config.Username.IsNull() || !config.Password.IsNull() {
+		// Credentials from the configuration take precedence over an API
+		// token from the environment.
+		apiToken = ""
+	}
+	if !config.Username.IsNull() {
+		username = config.Username.ValueString()
+	}
+	if !config.Password.IsNull() {
+		password = config.Password.ValueString()
+	}
+	if !config.MintAPIToken.IsNull() {
+		mintAPIToken = strconv.FormatBool(config.MintAPIToken.ValueBool())
 	}
 	if !config.TlsSkipVerify.IsNull() {
 		tlsSkipVerify = strconv.FormatBool(config.TlsSkipVerify.ValueBool())
 	}
+	if !config.MaxRetries.IsNull() {
+		maxRetries = strconv.FormatInt(config.MaxRetries.ValueInt64(), 10)
+	}
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = config.RetryMaxWait.ValueString()
+	}
+	if !config.RetryUnsafe.IsNull() {
+		retryUnsafe = strconv.FormatBool(config.RetryUnsafe.ValueBool())
+	}
+	if !config.CACertPEM.IsNull() {
+		caCertPEM = config.CACertPEM.ValueString()
+	}
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+	if !config.ClientCertPEM.IsNull() {
+		clientCertPEM = config.ClientCertPEM.ValueString()
+	}
+	if !config.ClientKeyPEM.IsNull() {
+		clientKeyPEM = config.ClientKeyPEM.ValueString()
+	}
+	if !config.HTTPProxy.IsNull() {
+		httpProxy = config.HTTPProxy.ValueString()
+	}
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = config.RequestTimeout.ValueString()
+	}
+
+	headers := map[string]string{}
+	if !config.Headers.IsNull() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+	} else if headersJSON != "" {
+		if err := json.Unmarshal([]byte(headersJSON), &headers); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers"),
+				"Invalid SemaphoreUI Headers",
+				"The SEMAPHOREUI_HEADERS environment variable must be a JSON object of header names to values: "+err.Error(),
+			)
+		}
+	}
 
 	// If any of the expected configurations are missing, use defaults or return
 	// errors with provider-specific guidance.
@@ -144,11 +405,26 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
-	if apiToken == "" {
+	if apiToken == "" && username == "" && password == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
 			"Missing SemaphoreUI API Token",
 			"Set the API Token value in the configuration or use the SEMAPHOREUI_API_TOKEN environment variable. "+
+				"Alternatively, set the username and password values in the configuration or use the SEMAPHOREUI_USERNAME and SEMAPHOREUI_PASSWORD environment variables. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	} else if apiToken == "" && username == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing SemaphoreUI Username",
+			"A password is set but no username. Set the username value in the configuration or use the SEMAPHOREUI_USERNAME environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	} else if apiToken == "" && password == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing SemaphoreUI Password",
+			"A username is set but no password. Set the password value in the configuration or use the SEMAPHOREUI_PASSWORD environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -161,6 +437,48 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 		tlsSkipVerify = "false" // Default
 	}
 
+	retries := defaultMaxRetries
+	if maxRetries != "" {
+		value, err := strconv.Atoi(maxRetries)
+		if err != nil || value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid SemaphoreUI Max Retries",
+				"The maximum number of retries must be a number of at least 0, got "+strconv.Quote(maxRetries)+". "+
+					"Set a valid value in the configuration or the SEMAPHOREUI_MAX_RETRIES environment variable.",
+			)
+		}
+		retries = value
+	}
+
+	retryWait := defaultRetryMaxWait
+	if retryMaxWait != "" {
+		value, err := time.ParseDuration(retryMaxWait)
+		if err != nil || value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid SemaphoreUI Retry Max Wait",
+				"The maximum wait between retries must be a Go duration such as 30s, got "+strconv.Quote(retryMaxWait)+". "+
+					"Set a valid value in the configuration or the SEMAPHOREUI_RETRY_MAX_WAIT environment variable.",
+			)
+		}
+		retryWait = value
+	}
+
+	var timeout time.Duration
+	if requestTimeout != "" {
+		value, err := time.ParseDuration(requestTimeout)
+		if err != nil || value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid SemaphoreUI Request Timeout",
+				"The request timeout must be a Go duration such as 30s, got "+strconv.Quote(requestTimeout)+". "+
+					"Set a valid value in the configuration or the SEMAPHOREUI_REQUEST_TIMEOUT environment variable.",
+			)
+		}
+		timeout = value
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -176,58 +494,144 @@ func (p *SemaphoreUIProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	var rt *httptransport.Runtime
-	if tlsSkipVerify == "true" {
-		transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-		httpClient := &http.Client{Transport: transport}
-		rt = httptransport.NewWithClient(u.Host, u.Path, []string{u.Scheme}, httpClient)
+	// Log the API requests in their own subsystem, and never log the
+	// configured credentials, even where the body redaction misses them.
+	logCtx := tflog.NewSubsystem(ctx, logSubsystem)
+	var logSecrets []string
+	for _, secret := range []string{apiToken, password, clientKeyPEM} {
+		if secret != "" {
+			logSecrets = append(logSecrets, secret)
+		}
+	}
+	for _, value := range headers {
+		if value != "" {
+			logSecrets = append(logSecrets, value)
+		}
+	}
+	logCtx = tflog.SubsystemMaskAllFieldValuesStrings(logCtx, logSubsystem, logSecrets...)
+
+	httpClient, err := newHTTPClient(logCtx, httpClientConfig{
+		TLSSkipVerify:  tlsSkipVerify == "true",
+		CACertPEM:      caCertPEM,
+		CACertFile:     caCertFile,
+		ClientCertPEM:  clientCertPEM,
+		ClientKeyPEM:   clientKeyPEM,
+		HTTPProxy:      httpProxy,
+		RequestTimeout: timeout,
+		Headers:        headers,
+		MaxRetries:     retries,
+		RetryMaxWait:   retryWait,
+		RetryUnsafe:    retryUnsafe == "true",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create SemaphoreUI API Client",
+			"The provider cannot create the SemaphoreUI API client from the TLS and proxy settings: "+err.Error(),
+		)
+		return
+	}
+	rt := httptransport.NewWithClient(u.Host, u.Path, []string{u.Scheme}, httpClient)
+	if apiToken != "" {
+		rt.DefaultAuthentication = httptransport.BearerToken(apiToken)
+	}
+
+	client := apiclient.New(apiErrorRuntime{rt}, strfmt.Default)
+
+	if apiToken == "" {
+		if err := login(client, rt, httpClient, username, password, mintAPIToken == "true"); err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics(
+				"Unable to Log In to SemaphoreUI",
+				"The provider could not log in to SemaphoreUI with the configured username and password",
+				err,
+			)...)
+			return
+		}
+	}
+
+	// Pass the server information on, so that resources can adapt to the
+	// server version and settings. Resources assume that every feature is
+	// supported when it is not known.
+	data := &providerData{client: client}
+	info, err := readServerInfo(client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read SemaphoreUI Server Information",
+			"The provider could not read the version and settings of the SemaphoreUI server, so it cannot warn about features the server does not support: "+err.Error(),
+		)
 	} else {
-		rt = httptransport.New(u.Host, u.Path, []string{u.Scheme})
+		data.serverInfo = info
 	}
-	rt.DefaultAuthentication = httptransport.BearerToken(apiToken)
 
-	client := apiclient.New(rt, strfmt.Default)
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
 }
 
 func (p *SemaphoreUIProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIntegrationAliasResource,
 		NewProjectEnvironmentResource,
+		NewProjectIntegrationExtractValueResource,
+		NewProjectIntegrationMatcherResource,
 		NewProjectIntegrationResource,
 		NewProjectInventoryResource,
+		NewProjectInviteResource,
 		NewProjectKeyResource,
+		NewProjectMembersResource,
 		NewProjectRepositoryResource,
 		NewProjectResource,
+		NewProjectRestoreResource,
 		NewProjectRunnerResource,
 		NewProjectScheduleResource,
+		NewProjectTaskResource,
 		NewProjectTemplateResource,
 		NewProjectUserResource,
 		NewProjectViewResource,
 		NewRunnerRegistrationTokenResource,
 		NewRunnerResource,
+		NewUserAPITokenResource,
 		NewUserResource,
 	}
 }
 
 func (p *SemaphoreUIProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewEventsDataSource,
 		NewExternalUserDataSource,
+		NewInfoDataSource,
+		NewProjectBackupDataSource,
 		NewProjectDataSource,
 		NewProjectEnvironmentDataSource,
+		NewProjectEnvironmentsDataSource,
+		NewProjectEventsDataSource,
 		NewProjectIntegrationDataSource,
+		NewProjectIntegrationsDataSource,
 		NewProjectInventoryDataSource,
+		NewProjectInventoriesDataSource,
 		NewProjectKeyDataSource,
+		NewProjectKeysDataSource,
 		NewProjectRepositoryDataSource,
+		NewProjectRepositoriesDataSource,
 		NewProjectRunnerDataSource,
+		NewProjectRunnersDataSource,
 		NewProjectScheduleDataSource,
 		NewProjectsDataSource,
+		NewProjectTaskDataSource,
 		NewProjectTemplateDataSource,
+		NewProjectTemplatesDataSource,
 		NewProjectUserDataSource,
 		NewProjectViewDataSource,
+		NewProjectViewsDataSource,
 		NewRunnerDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
+	}
+}
+
+func (p *SemaphoreUIProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewRunnerRegistrationTokenEphemeralResource,
+		NewUserAPITokenEphemeralResource,
 	}
 }
 
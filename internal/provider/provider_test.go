@@ -1,7 +1,11 @@
 package provider
 
 import (
+	"net/url"
 	"os"
+	"regexp"
+	"sync"
+	"terraform-provider-semaphoreui/internal/fakesemaphore"
 	"terraform-provider-semaphoreui/semaphoreui/client"
 	"testing"
 
@@ -10,12 +14,22 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 var (
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"semaphoreui": providerserver.NewProtocol6WithError(New("test")()),
 	}
+
+	// testAccProtoV6ProviderFactoriesWithEcho adds the echo provider, which
+	// stores the data it is configured with in the state of its resource, to
+	// test the results of ephemeral resources.
+	testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+		"semaphoreui": providerserver.NewProtocol6WithError(New("test")()),
+		"echo":        echoprovider.NewProviderServer(),
+	}
 )
 
 func mustHaveEnv(t *testing.T, name string) {
@@ -24,7 +38,25 @@ func mustHaveEnv(t *testing.T, name string) {
 	}
 }
 
+// testAccFakeServer is the fake SemaphoreUI API that acceptance tests run
+// against when no SemaphoreUI server is configured. It is shared by all tests,
+// like a real server.
+var (
+	testAccFakeServer     *fakesemaphore.Server
+	testAccFakeServerOnce sync.Once
+)
+
+// testAccPreCheck checks that the tests can reach a SemaphoreUI API. Without
+// SEMAPHOREUI_API_BASE_URL, it starts an in-memory fake of the API and points
+// the provider at it, so that the tests run without a SemaphoreUI server.
 func testAccPreCheck(t *testing.T) {
+	if os.Getenv("SEMAPHOREUI_API_BASE_URL") == "" {
+		testAccFakeServerOnce.Do(func() {
+			testAccFakeServer = fakesemaphore.New()
+			os.Setenv("SEMAPHOREUI_API_BASE_URL", testAccFakeServer.APIURL())
+			os.Setenv("SEMAPHOREUI_API_TOKEN", fakesemaphore.Token)
+		})
+	}
 	mustHaveEnv(t, "SEMAPHOREUI_API_BASE_URL")
 	mustHaveEnv(t, "SEMAPHOREUI_API_TOKEN")
 }
@@ -33,8 +65,12 @@ var tc *client.SemaphoreUI
 
 func testClient() *client.SemaphoreUI {
 	if tc == nil {
+		apiURL, err := url.Parse(testApiURL())
+		if err != nil {
+			panic("invalid SEMAPHOREUI_API_BASE_URL: " + err.Error())
+		}
 
-		r := httptransport.New("localhost:13000", "/api", []string{"http"})
+		r := httptransport.New(apiURL.Host, apiURL.Path, []string{apiURL.Scheme})
 		r.DefaultAuthentication = httptransport.BearerToken(testApiToken())
 
 		tc = client.New(r, strfmt.Default)
@@ -42,10 +78,53 @@ func testClient() *client.SemaphoreUI {
 	return tc
 }
 
-// func testApiURL() string {
-// 	return os.Getenv("SEMAPHOREUI_API_BASE_URL")
-// }
+func testApiURL() string {
+	return os.Getenv("SEMAPHOREUI_API_BASE_URL")
+}
 
 func testApiToken() string {
 	return os.Getenv("SEMAPHOREUI_API_TOKEN")
 }
+
+func TestAcc_Provider_usernamePassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "semaphoreui" {
+  username = "admin"
+  password = "admin"
+}
+
+data "semaphoreui_info" "test" {}
+`,
+				Check: resource.TestCheckResourceAttrSet("data.semaphoreui_info.test", "version"),
+			},
+			{
+				Config: `
+provider "semaphoreui" {
+  username       = "admin"
+  password       = "admin"
+  mint_api_token = true
+}
+
+data "semaphoreui_info" "test" {}
+`,
+				Check: resource.TestCheckResourceAttrSet("data.semaphoreui_info.test", "version"),
+			},
+			{
+				Config: `
+provider "semaphoreui" {
+  username = "admin"
+  password = "wrong"
+}
+
+data "semaphoreui_info" "test" {}
+`,
+				ExpectError: regexp.MustCompile("Unable to Log In to SemaphoreUI"),
+			},
+		},
+	})
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// defaultMaxRetries is the number of retries when max_retries is not set.
+	defaultMaxRetries = 3
+	// defaultRetryMaxWait is the longest wait between retries when
+	// retry_max_wait is not set.
+	defaultRetryMaxWait = 30 * time.Second
+	// retryMinWait is the wait before the first retry, doubled for every
+	// further retry.
+	retryMinWait = 500 * time.Millisecond
+)
+
+// retryTransport retries requests that failed because of transient errors:
+// connection errors, 429 Too Many Requests and 5xx responses. By default only
+// safe methods are retried, as the server may have processed a failed request
+// that changes data. The timeout, if set, applies to every attempt on its
+// own, including the reading of the response body, and not to the waits
+// between them.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+	timeout    time.Duration
+	// retryUnsafe also retries the requests of unsafe methods.
+	retryUnsafe bool
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxWait time.Duration, timeout time.Duration, retryUnsafe bool) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &retryTransport{
+		next:        next,
+		maxRetries:  maxRetries,
+		maxWait:     maxWait,
+		timeout:     timeout,
+		retryUnsafe: retryUnsafe,
+	}
+}
+
+// cancelBody cancels the context of an attempt once its response body is
+// closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// attempt sends the request once, within the timeout of the transport.
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	response, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && req.Context().Err() == nil {
+			return response, fmt.Errorf("request timed out after %s: %w", t.timeout, err)
+		}
+		return response, err
+	}
+	response.Body = &cancelBody{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// isSafeMethod reports whether requests with the method do not change data,
+// and so can be retried.
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// canRetry reports whether the request may be sent again. A request with a
+// body can only be retried if the body can be read again.
+func (t *retryTransport) canRetry(req *http.Request) bool {
+	if isSafeMethod(req.Method) {
+		return true
+	}
+	return t.retryUnsafe && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+}
+
+// isRetryableStatus reports whether a response status indicates a transient
+// failure.
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryWait returns how long to wait before the given retry (starting at 0):
+// the delay requested by a Retry-After header, or an exponential backoff with
+// full jitter. The wait never exceeds maxWait.
+func (t *retryTransport) retryWait(retry int, response *http.Response) time.Duration {
+	if response != nil {
+		if retryAfter := response.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+				return min(time.Duration(seconds)*time.Second, t.maxWait)
+			}
+			if date, err := http.ParseTime(retryAfter); err == nil {
+				return min(max(time.Until(date), 0), t.maxWait)
+			}
+		}
+	}
+
+	backoff := t.maxWait
+	if retry < 32 {
+		backoff = min(retryMinWait<<retry, t.maxWait)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return rand.N(backoff) + 1
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.canRetry(req) {
+		return t.attempt(req)
+	}
+
+	for retry := 0; ; retry++ {
+		if retry > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		response, err := t.attempt(req)
+		if retry >= t.maxRetries || (err == nil && !isRetryableStatus(response.StatusCode)) {
+			return response, err
+		}
+
+		wait := t.retryWait(retry, response)
+		if response != nil {
+			// Drain and close the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1)) - 1
+		status := http.StatusOK
+		if call < len(statuses) {
+			status = statuses[call]
+		}
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "0")
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestRetryTransport_retriesTransientErrors(t *testing.T) {
+	server, calls := testRetryServer(t, http.StatusBadGateway, http.StatusTooManyRequests)
+	client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 0, false)}
+
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", response.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", calls.Load())
+	}
+}
+
+func TestRetryTransport_stopsAfterMaxRetries(t *testing.T) {
+	server, calls := testRetryServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	client := &http.Client{Transport: newRetryTransport(nil, 1, time.Millisecond, 0, false)}
+
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", response.StatusCode)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", calls.Load())
+	}
+}
+
+func TestRetryTransport_doesNotRetryUnsafeMethods(t *testing.T) {
+	server, calls := testRetryServer(t, http.StatusBadGateway)
+	client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 0, false)}
+
+	response, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", response.StatusCode)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+}
+
+func TestRetryTransport_retriesUnsafeMethods(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 0, true)}
+
+	response, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", response.StatusCode)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Errorf("expected the same body to be sent twice, got %q", bodies)
+	}
+}
+
+func TestRetryTransport_retryWait(t *testing.T) {
+	transport := newRetryTransport(nil, 3, 10*time.Second, 0, false)
+
+	response := &http.Response{Header: http.Header{"Retry-After": []string{"4"}}}
+	if wait := transport.retryWait(0, response); wait != 4*time.Second {
+		t.Errorf("expected Retry-After wait of 4s, got %s", wait)
+	}
+
+	response.Header.Set("Retry-After", "120")
+	if wait := transport.retryWait(0, response); wait != 10*time.Second {
+		t.Errorf("expected Retry-After wait capped to 10s, got %s", wait)
+	}
+
+	for retry := 0; retry < 40; retry++ {
+		if wait := transport.retryWait(retry, nil); wait <= 0 || wait > 10*time.Second {
+			t.Errorf("retry %d: expected backoff in (0s, 10s], got %s", retry, wait)
+		}
+	}
+}
+
+func TestRetryTransport_timeoutPerAttempt(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the first attempt is too slow.
+		if calls.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	// The retry waits are longer than the timeout, which only limits each
+	// attempt.
+	client := &http.Client{Transport: newRetryTransport(nil, 2, 200*time.Millisecond, 100*time.Millisecond, false)}
+
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", response.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", calls.Load())
+	}
+
+	calls.Store(0)
+	_, err = client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err == nil || !strings.Contains(err.Error(), "timed out after 100ms") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+}
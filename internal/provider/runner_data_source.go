@@ -27,15 +27,15 @@ func (d *runnerDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 func (d *runnerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -58,10 +58,7 @@ func (d *runnerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			RunnerID: config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Runner",
-				"Could not read runner, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Runner", "Could not read runner", err)...)
 			return
 		}
 		model, diags := convertRunnerResponseToRunnerModel(ctx, response.Payload)
@@ -75,10 +72,7 @@ func (d *runnerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	response, err := d.client.Runner.GetRunners(&runner.GetRunnersParams{}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Runners",
-			"Could not read runners, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Runners", "Could not read runners", err)...)
 		return
 	}
 	for _, item := range response.Payload {
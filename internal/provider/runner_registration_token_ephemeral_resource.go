@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &runnerRegistrationTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &runnerRegistrationTokenEphemeralResource{}
+)
+
+func NewRunnerRegistrationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &runnerRegistrationTokenEphemeralResource{}
+}
+
+type runnerRegistrationTokenEphemeralResource struct {
+	client     *apiclient.SemaphoreUI
+	serverInfo *models.InfoType
+}
+
+func (r *runnerRegistrationTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+	r.serverInfo = data.serverInfo
+}
+
+func (r *runnerRegistrationTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runner_registration_token"
+}
+
+func (r *runnerRegistrationTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = RunnerRegistrationTokenEphemeralSchema()
+}
+
+// Open generates a new token on every run. There is no Close: the API has no
+// endpoint to revoke a registration token, which is one-time and short-lived
+// anyway.
+func (r *runnerRegistrationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config RunnerRegistrationTokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(warnRemoteRunnersDisabled(r.serverInfo, "A runner registration token")...)
+
+	payload, err := generateRunnerRegistrationToken(r.client, config.ProjectID, config.RunnerID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Generating SemaphoreUI Runner Registration Token", "Could not generate registration token", err)...)
+		return
+	}
+
+	result := RunnerRegistrationTokenEphemeralModel{
+		RunnerID:          types.Int64Value(payload.RunnerID),
+		ProjectID:         types.Int64PointerValue(payload.ProjectID),
+		RegistrationToken: types.StringValue(payload.RegistrationToken),
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+}
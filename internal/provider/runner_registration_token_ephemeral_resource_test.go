@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func testAccRunnerRegistrationTokenEphemeralConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_runner" "test" {
+  name   = "Test %[1]s"
+  active = false
+}
+
+ephemeral "semaphoreui_runner_registration_token" "test" {
+  runner_id = semaphoreui_runner.test.id
+}
+
+provider "echo" {
+  data = ephemeral.semaphoreui_runner_registration_token.test
+}
+
+resource "echo" "test" {}
+`, nameSuffix)
+}
+
+func testAccProjectRunnerRegistrationTokenEphemeralConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_project" "test" {
+  name = "test-%[1]s"
+}
+
+resource "semaphoreui_project_runner" "test" {
+  project_id = semaphoreui_project.test.id
+  name       = "Test %[1]s"
+  active     = false
+}
+
+ephemeral "semaphoreui_runner_registration_token" "test" {
+  project_id = semaphoreui_project.test.id
+  runner_id  = semaphoreui_project_runner.test.id
+}
+
+provider "echo" {
+  data = ephemeral.semaphoreui_runner_registration_token.test
+}
+
+resource "echo" "test" {}
+`, nameSuffix)
+}
+
+func TestAcc_RunnerRegistrationTokenEphemeralResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckRunnerRegistrationToken(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunnerRegistrationTokenEphemeralConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.registration_token"),
+					resource.TestCheckResourceAttrPair("echo.test", "data.runner_id", "semaphoreui_runner.test", "id"),
+					resource.TestCheckNoResourceAttr("echo.test", "data.project_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_RunnerRegistrationTokenEphemeralResource_project(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckRunnerRegistrationToken(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRunnerRegistrationTokenEphemeralConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.registration_token"),
+					resource.TestCheckResourceAttrPair("echo.test", "data.runner_id", "semaphoreui_project_runner.test", "id"),
+					resource.TestCheckResourceAttrPair("echo.test", "data.project_id", "semaphoreui_project.test", "id"),
+				),
+			},
+		},
+	})
+}
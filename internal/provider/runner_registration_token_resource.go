@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -23,8 +22,9 @@ func runnerRegistrationTokenID(projectID *int64, runnerID int64) string {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &runnerRegistrationTokenResource{}
-	_ resource.ResourceWithConfigure = &runnerRegistrationTokenResource{}
+	_ resource.Resource               = &runnerRegistrationTokenResource{}
+	_ resource.ResourceWithConfigure  = &runnerRegistrationTokenResource{}
+	_ resource.ResourceWithModifyPlan = &runnerRegistrationTokenResource{}
 )
 
 func NewRunnerRegistrationTokenResource() resource.Resource {
@@ -32,7 +32,8 @@ func NewRunnerRegistrationTokenResource() resource.Resource {
 }
 
 type runnerRegistrationTokenResource struct {
-	client *apiclient.SemaphoreUI
+	client     *apiclient.SemaphoreUI
+	serverInfo *models.InfoType
 }
 
 func (r *runnerRegistrationTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -40,15 +41,16 @@ func (r *runnerRegistrationTokenResource) Configure(_ context.Context, req resou
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
+	r.serverInfo = data.serverInfo
 }
 
 func (r *runnerRegistrationTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,6 +66,35 @@ func (m RunnerRegistrationTokenModel) isProject() bool {
 	return !m.ProjectID.IsNull() && !m.ProjectID.IsUnknown()
 }
 
+// generateRunnerRegistrationToken generates a registration token for a
+// project runner, or for a global runner when projectID is null.
+func generateRunnerRegistrationToken(client *apiclient.SemaphoreUI, projectID types.Int64, runnerID int64) (*models.RunnerRegistrationToken, error) {
+	if !projectID.IsNull() && !projectID.IsUnknown() {
+		response, err := client.Runner.PostProjectProjectIDRunnersRunnerIDRegistrationToken(&runner.PostProjectProjectIDRunnersRunnerIDRegistrationTokenParams{
+			ProjectID: projectID.ValueInt64(),
+			RunnerID:  runnerID,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	}
+
+	response, err := client.Runner.PostRunnersRunnerIDRegistrationToken(&runner.PostRunnersRunnerIDRegistrationTokenParams{
+		RunnerID: runnerID,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// ModifyPlan warns at plan time when remote runners are disabled on the
+// server.
+func (r *runnerRegistrationTokenResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnRemoteRunnersDisabledOnCreate(r.serverInfo, "A runner registration token", req, resp)
+}
+
 func (r *runnerRegistrationTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan RunnerRegistrationTokenModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -71,32 +102,10 @@ func (r *runnerRegistrationTokenResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	var payload *models.RunnerRegistrationToken
-	if plan.isProject() {
-		response, err := r.client.Runner.PostProjectProjectIDRunnersRunnerIDRegistrationToken(&runner.PostProjectProjectIDRunnersRunnerIDRegistrationTokenParams{
-			ProjectID: plan.ProjectID.ValueInt64(),
-			RunnerID:  plan.RunnerID.ValueInt64(),
-		}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Generating SemaphoreUI Runner Registration Token",
-				"Could not generate registration token, unexpected error: "+err.Error(),
-			)
-			return
-		}
-		payload = response.Payload
-	} else {
-		response, err := r.client.Runner.PostRunnersRunnerIDRegistrationToken(&runner.PostRunnersRunnerIDRegistrationTokenParams{
-			RunnerID: plan.RunnerID.ValueInt64(),
-		}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Generating SemaphoreUI Runner Registration Token",
-				"Could not generate registration token, unexpected error: "+err.Error(),
-			)
-			return
-		}
-		payload = response.Payload
+	payload, err := generateRunnerRegistrationToken(r.client, plan.ProjectID, plan.RunnerID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Generating SemaphoreUI Runner Registration Token", "Could not generate registration token", err)...)
+		return
 	}
 
 	// Populate identifiers from the response: runner_id is read-only and
@@ -125,15 +134,11 @@ func (r *runnerRegistrationTokenResource) Read(ctx context.Context, req resource
 			RunnerID:  state.RunnerID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			var notFound *runner.GetProjectProjectIDRunnersRunnerIDNotFound
-			if errors.As(err, &notFound) {
+			if isNotFound(err) {
 				resp.State.RemoveResource(ctx)
 				return
 			}
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Project Runner",
-				"Could not read project runner, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Project Runner", "Could not read project runner", err)...)
 			return
 		}
 	} else {
@@ -141,15 +146,11 @@ func (r *runnerRegistrationTokenResource) Read(ctx context.Context, req resource
 			RunnerID: state.RunnerID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			var notFound *runner.GetRunnersRunnerIDNotFound
-			if errors.As(err, &notFound) {
+			if isNotFound(err) {
 				resp.State.RemoveResource(ctx)
 				return
 			}
-			resp.Diagnostics.AddError(
-				"Error Reading SemaphoreUI Runner",
-				"Could not read runner, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Runner", "Could not read runner", err)...)
 			return
 		}
 	}
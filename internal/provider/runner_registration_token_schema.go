@@ -1,6 +1,7 @@
 package provider
 
 import (
+	schemaE "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -18,6 +19,12 @@ type RunnerRegistrationTokenModel struct {
 	RegistrationToken types.String `tfsdk:"registration_token"`
 }
 
+type RunnerRegistrationTokenEphemeralModel struct {
+	RunnerID          types.Int64  `tfsdk:"runner_id"`
+	ProjectID         types.Int64  `tfsdk:"project_id"`
+	RegistrationToken types.String `tfsdk:"registration_token"`
+}
+
 func RunnerRegistrationTokenSchema() superschema.Schema {
 	return superschema.Schema{
 		Common: superschema.SchemaDetails{
@@ -25,9 +32,11 @@ func RunnerRegistrationTokenSchema() superschema.Schema {
 		},
 		Resource: superschema.SchemaDetails{
 			Deprecated: superschema.DeprecatedResource{
-				DeprecationMessage: "The semaphoreui_runner_registration_token resource is deprecated and will be removed in a future release.",
+				DeprecationMessage: "The semaphoreui_runner_registration_token resource is deprecated and will be removed in a future release. " +
+					"Use the semaphoreui_runner_registration_token ephemeral resource instead, which does not store the token in state.",
 			},
-			MarkdownDescription: "**Deprecated:** this resource is deprecated and will be removed in a future release. " +
+			MarkdownDescription: "**Deprecated:** this resource is deprecated and will be removed in a future release; use the " +
+				"`semaphoreui_runner_registration_token` ephemeral resource instead, which does not store the token in state. This " +
 				"resource generates a fresh one-time registration token for an existing, unregistered runner. " +
 				"Regenerating invalidates the previous token. The token is returned only once, at creation, and stored " +
 				"(sensitive) in Terraform state. The resource is immutable: changing `runner_id`, `project_id` or `keepers` " +
@@ -88,3 +97,30 @@ func RunnerRegistrationTokenSchema() superschema.Schema {
 		},
 	}
 }
+
+// RunnerRegistrationTokenEphemeralSchema is the schema of the ephemeral
+// resource, which superschema does not support.
+func RunnerRegistrationTokenEphemeralSchema() schemaE.Schema {
+	return schemaE.Schema{
+		MarkdownDescription: "The runner registration token ephemeral resource generates a fresh one-time registration token for an existing, " +
+			"unregistered runner, for the duration of a Terraform run. The token is never stored in the plan or state, so it can be passed " +
+			"to other providers (e.g. in the cloud-init configuration of a runner VM) without being persisted. Every run generates a new token, " +
+			"which invalidates the previous one. The runner must not already be registered, otherwise the API returns an error. " +
+			"Requires Terraform 1.10 or later.",
+		Attributes: map[string]schemaE.Attribute{
+			"runner_id": schemaE.Int64Attribute{
+				MarkdownDescription: "The ID of the runner to generate a registration token for.",
+				Required:            true,
+			},
+			"project_id": schemaE.Int64Attribute{
+				MarkdownDescription: "The project ID that owns the runner. Set this for project runners; omit it for global (admin) runners.",
+				Optional:            true,
+			},
+			"registration_token": schemaE.StringAttribute{
+				MarkdownDescription: "The generated one-time registration token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
@@ -2,32 +2,22 @@ package provider
 
 import (
 	"context"
-	"errors"
-	"net/http"
 
 	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
 	"terraform-provider-semaphoreui/semaphoreui/client/runner"
 	"terraform-provider-semaphoreui/semaphoreui/models"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// isRunnerNotFound reports whether err is an HTTP 404 returned by the
-// SemaphoreUI API. Undocumented status codes surface as *runtime.APIError, so a
-// delete of an already-removed runner is treated as a no-op (idempotent).
-func isRunnerNotFound(err error) bool {
-	var apiErr *runtime.APIError
-	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
-}
-
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &runnerResource{}
 	_ resource.ResourceWithConfigure   = &runnerResource{}
 	_ resource.ResourceWithImportState = &runnerResource{}
+	_ resource.ResourceWithModifyPlan  = &runnerResource{}
 )
 
 func NewRunnerResource() resource.Resource {
@@ -35,7 +25,8 @@ func NewRunnerResource() resource.Resource {
 }
 
 type runnerResource struct {
-	client *apiclient.SemaphoreUI
+	client     *apiclient.SemaphoreUI
+	serverInfo *models.InfoType
 }
 
 func (r *runnerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -43,16 +34,17 @@ func (r *runnerResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
+	r.serverInfo = data.serverInfo
 }
 
 func (r *runnerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -105,6 +97,12 @@ func convertRunnerResponseToRunnerModel(ctx context.Context, response *models.Ru
 	return model, diags
 }
 
+// ModifyPlan warns at plan time when remote runners are disabled on the
+// server.
+func (r *runnerResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnRemoteRunnersDisabledOnCreate(r.serverInfo, "A global runner", req, resp)
+}
+
 func (r *runnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan RunnerModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -122,18 +120,12 @@ func (r *runnerResource) Create(ctx context.Context, req resource.CreateRequest,
 		Runner: request,
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI Runner",
-			"Could not create runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI Runner", "Could not create runner", err)...)
 		return
 	}
 
 	if err := r.ensureActive(response.Payload.ID, plan.Active.ValueBool(), response.Payload.Active); err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting SemaphoreUI Runner Active State",
-			"Could not set runner active state, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting SemaphoreUI Runner Active State", "Could not set runner active state", err)...)
 		return
 	}
 
@@ -174,16 +166,12 @@ func (r *runnerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		RunnerID: state.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		var notFound *runner.GetRunnersRunnerIDNotFound
-		if errors.As(err, &notFound) {
+		if isNotFound(err) {
 			// Drift: runner deleted out-of-band. Remove from state.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Runner",
-			"Could not read runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Runner", "Could not read runner", err)...)
 		return
 	}
 
@@ -215,10 +203,7 @@ func (r *runnerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Runner:   request,
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SemaphoreUI Runner",
-			"Could not update runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating SemaphoreUI Runner", "Could not update runner", err)...)
 		return
 	}
 
@@ -226,18 +211,12 @@ func (r *runnerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		RunnerID: plan.ID.ValueInt64(),
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Runner",
-			"Could not read runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Runner", "Could not read runner", err)...)
 		return
 	}
 
 	if err := r.ensureActive(plan.ID.ValueInt64(), plan.Active.ValueBool(), response.Payload.Active); err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting SemaphoreUI Runner Active State",
-			"Could not set runner active state, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting SemaphoreUI Runner Active State", "Could not set runner active state", err)...)
 		return
 	}
 
@@ -261,22 +240,16 @@ func (r *runnerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	_, err := r.client.Runner.DeleteRunnersRunnerID(&runner.DeleteRunnersRunnerIDParams{
 		RunnerID: state.ID.ValueInt64(),
 	}, nil)
-	if err != nil && !isRunnerNotFound(err) {
-		resp.Diagnostics.AddError(
-			"Error Removing SemaphoreUI Runner",
-			"Could not remove runner, unexpected error: "+err.Error(),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI Runner", "Could not remove runner", err)...)
 		return
 	}
 }
 
 func (r *runnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"runner"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"runner"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Runner Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid Runner Import ID", "Could not parse import ID", err)...)
 		return
 	}
 
@@ -284,10 +257,7 @@ func (r *runnerResource) ImportState(ctx context.Context, req resource.ImportSta
 		RunnerID: fields["runner"],
 	}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading SemaphoreUI Runner",
-			"Could not read runner, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Runner", "Could not read runner", err)...)
 		return
 	}
 
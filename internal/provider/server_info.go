@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/operations"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// providerData is handed by the provider to its resources, data sources and
+// ephemeral resources.
+type providerData struct {
+	client *apiclient.SemaphoreUI
+	// serverInfo is the server information read when the provider was
+	// configured, or nil when it could not be read.
+	serverInfo *models.InfoType
+}
+
+// serverVersionRegex matches the numeric part of SemaphoreUI versions such as
+// "v2.16.18" or "2.15.0-ab12cd3-1744211357".
+var serverVersionRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// readServerInfo reads the server information.
+func readServerInfo(client *apiclient.SemaphoreUI) (*models.InfoType, error) {
+	response, err := client.Operations.GetInfo(&operations.GetInfoParams{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// parseServerVersion returns the major, minor and patch numbers of a
+// SemaphoreUI version. Development builds have no version number.
+func parseServerVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+	match := serverVersionRegex.FindStringSubmatch(version)
+	if match == nil {
+		return parsed, false
+	}
+	for i := range parsed {
+		if match[i+1] != "" {
+			parsed[i], _ = strconv.Atoi(match[i+1])
+		}
+	}
+	return parsed, true
+}
+
+// serverVersionAtLeast reports whether the server runs at least the given
+// version. It returns true when the server version is not known, so that
+// features are only refused when the server is known not to support them.
+func serverVersionAtLeast(info *models.InfoType, minVersion string) bool {
+	if info == nil {
+		return true
+	}
+	current, ok := parseServerVersion(info.Version)
+	if !ok {
+		return true
+	}
+	required, _ := parseServerVersion(minVersion)
+	for i := range current {
+		if current[i] != required[i] {
+			return current[i] > required[i]
+		}
+	}
+	return true
+}
+
+// warnRemoteRunnersDisabled returns a warning diagnostic when the server is
+// known to have remote runners disabled: runners can still be managed, but no
+// task is run on them.
+func warnRemoteRunnersDisabled(info *models.InfoType, feature string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if info != nil && !info.UseRemoteRunner {
+		diags.AddWarning(
+			"SemaphoreUI Remote Runners Disabled",
+			fmt.Sprintf("%s has no effect until remote runners are enabled on the SemaphoreUI server (version %s). "+
+				"Enable them with the use_remote_runner setting or the SEMAPHORE_USE_REMOTE_RUNNER environment variable of the server.", feature, info.Version),
+		)
+	}
+	return diags
+}
+
+// warnRemoteRunnersDisabledOnCreate adds the warning of
+// warnRemoteRunnersDisabled to the plan of a resource that is created, so
+// that it is shown by terraform plan rather than next to a failed apply.
+func warnRemoteRunnersDisabledOnCreate(info *models.InfoType, feature string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(warnRemoteRunnersDisabled(info, feature)...)
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"terraform-provider-semaphoreui/semaphoreui/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected [3]int
+		ok       bool
+	}{
+		{"v2.16.18", [3]int{2, 16, 18}, true},
+		{"2.15.0-ab12cd3-1744211357", [3]int{2, 15, 0}, true},
+		{"2.10", [3]int{2, 10, 0}, true},
+		{"develop", [3]int{}, false},
+	}
+	for _, test := range tests {
+		parsed, ok := parseServerVersion(test.version)
+		if ok != test.ok || parsed != test.expected {
+			t.Errorf("parseServerVersion(%q) = %v, %t; want %v, %t", test.version, parsed, ok, test.expected, test.ok)
+		}
+	}
+}
+
+func TestWarnRemoteRunnersDisabledOnCreate(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}
+	null := tftypes.NewValue(objectType, nil)
+	planned := tftypes.NewValue(objectType, map[string]tftypes.Value{})
+	disabled := &models.InfoType{Version: "v2.16.18", UseRemoteRunner: false}
+
+	tests := []struct {
+		name     string
+		info     *models.InfoType
+		state    tftypes.Value
+		plan     tftypes.Value
+		warnings int
+	}{
+		{"create", disabled, null, planned, 1},
+		{"update", disabled, planned, planned, 0},
+		{"destroy", disabled, planned, null, 0},
+		{"enabled", &models.InfoType{Version: "v2.16.18", UseRemoteRunner: true}, null, planned, 0},
+		{"unknown server", nil, null, planned, 0},
+	}
+	for _, test := range tests {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Raw: test.state},
+			Plan:  tfsdk.Plan{Raw: test.plan},
+		}
+		var resp resource.ModifyPlanResponse
+		warnRemoteRunnersDisabledOnCreate(test.info, "A runner", req, &resp)
+		if len(resp.Diagnostics.Warnings()) != test.warnings || resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected %d warnings, got %v", test.name, test.warnings, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"sync"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/authentication"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+)
+
+// sessionAPITokenName is the name of the API tokens that the provider creates
+// for the run when mint_api_token is set.
+const sessionAPITokenName = "terraform-provider-semaphoreui"
+
+// sessionCleanups end the sessions of the provider instances that logged in
+// with a username and password, see Close.
+var (
+	sessionCleanupsMu sync.Mutex
+	sessionCleanups   []func(context.Context) error
+)
+
+// login logs the client in with a username and password. The session cookie
+// then authenticates the requests of the client. With mintToken, an API token
+// is created instead, the client authenticates with it, and the session is
+// ended right away. The session or token is ended by Close.
+func login(client *apiclient.SemaphoreUI, rt *httptransport.Runtime, httpClient *http.Client, username, password string, mintToken bool) error {
+	jar, _ := cookiejar.New(nil)
+	httpClient.Jar = jar
+	_, err := client.Authentication.PostAuthLogin(&authentication.PostAuthLoginParams{
+		LoginBody: &models.Login{
+			Auth:     username,
+			Password: strfmt.Password(password),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	logout := func(ctx context.Context) error {
+		_, err := client.Authentication.PostAuthLogoutContext(ctx, &authentication.PostAuthLogoutParams{}, nil)
+		return err
+	}
+	if !mintToken {
+		addSessionCleanup(logout)
+		return nil
+	}
+
+	response, err := client.Authentication.PostUserTokens(&authentication.PostUserTokensParams{
+		Body: authentication.PostUserTokensBody{
+			Name: sessionAPITokenName,
+		},
+	}, nil)
+	if err != nil {
+		return errors.Join(err, logout(context.Background()))
+	}
+	token := response.Payload.ID
+	if err := logout(context.Background()); err != nil {
+		return err
+	}
+	httpClient.Jar = nil
+	rt.DefaultAuthentication = httptransport.BearerToken(token)
+
+	addSessionCleanup(func(ctx context.Context) error {
+		_, err := client.Authentication.DeleteUserTokensAPITokenIDContext(ctx, &authentication.DeleteUserTokensAPITokenIDParams{
+			APITokenID: token,
+		}, nil)
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	})
+	return nil
+}
+
+func addSessionCleanup(cleanup func(context.Context) error) {
+	sessionCleanupsMu.Lock()
+	defer sessionCleanupsMu.Unlock()
+	sessionCleanups = append(sessionCleanups, cleanup)
+}
+
+// Close ends the sessions that the provider opened with a username and
+// password: it logs out, or expires the API token that it created for the
+// run. The provider server calls it once Terraform stops the provider.
+func Close(ctx context.Context) error {
+	sessionCleanupsMu.Lock()
+	cleanups := sessionCleanups
+	sessionCleanups = nil
+	sessionCleanupsMu.Unlock()
+
+	var errs []error
+	for _, cleanup := range cleanups {
+		errs = append(errs, cleanup(ctx))
+	}
+	return errors.Join(errs...)
+}
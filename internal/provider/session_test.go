@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"terraform-provider-semaphoreui/internal/fakesemaphore"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/authentication"
+	"terraform-provider-semaphoreui/semaphoreui/client/user"
+	"testing"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// testLoginClient returns a client of the fake server that is not
+// authenticated yet.
+func testLoginClient(t *testing.T, s *fakesemaphore.Server) (*apiclient.SemaphoreUI, *httptransport.Runtime, *http.Client) {
+	u, err := url.Parse(s.APIURL())
+	if err != nil {
+		t.Fatalf("invalid API URL: %s", err)
+	}
+	httpClient := &http.Client{}
+	rt := httptransport.NewWithClient(u.Host, u.Path, []string{u.Scheme}, httpClient)
+	return apiclient.New(rt, strfmt.Default), rt, httpClient
+}
+
+func TestLogin(t *testing.T) {
+	s := fakesemaphore.New()
+	defer s.Close()
+
+	client, rt, httpClient := testLoginClient(t, s)
+	if err := login(client, rt, httpClient, fakesemaphore.AdminUsername, "wrong", false); err == nil {
+		t.Error("login expected an error for a wrong password")
+	}
+
+	for _, mintToken := range []bool{false, true} {
+		client, rt, httpClient := testLoginClient(t, s)
+		if err := login(client, rt, httpClient, fakesemaphore.AdminUsername, fakesemaphore.AdminPassword, mintToken); err != nil {
+			t.Fatalf("login(mintToken=%t) returned an unexpected error: %s", mintToken, err)
+		}
+		if _, err := client.User.GetUser(&user.GetUserParams{}, nil); err != nil {
+			t.Fatalf("login(mintToken=%t) did not authenticate the client: %s", mintToken, err)
+		}
+
+		if mintToken {
+			if httpClient.Jar != nil {
+				t.Error("login expected the session to be dropped for a minted API token")
+			}
+			response, err := client.Authentication.GetUserTokens(&authentication.GetUserTokensParams{}, nil)
+			if err != nil {
+				t.Fatalf("could not list the API tokens: %s", err)
+			}
+			if len(response.Payload) != 1 || response.Payload[0].Expired {
+				t.Errorf("expected an active API token for the run, got %v", response.Payload)
+			}
+		}
+
+		if err := Close(context.Background()); err != nil {
+			t.Fatalf("Close(mintToken=%t) returned an unexpected error: %s", mintToken, err)
+		}
+		if _, err := client.User.GetUser(&user.GetUserParams{}, nil); err == nil {
+			t.Errorf("expected the session (mintToken=%t) to end on Close", mintToken)
+		}
+	}
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // TaskParamsModel mirrors the SemaphoreUI TaskPrams JSON object. Used by
-// both project templates and project integrations.
+// project templates, integrations and schedules.
 type TaskParamsModel struct {
 	Arguments   types.String              `tfsdk:"arguments"`
 	Environment types.String              `tfsdk:"environment"`
@@ -40,11 +40,12 @@ type TerraformTaskParamsModel struct {
 }
 
 // TaskParamsAttribute returns the shared `task_params` attribute used by
-// project_template and project_integration resources / data sources.
+// project_template, project_integration and project_schedule resources / data
+// sources.
 func TaskParamsAttribute() superschema.Attribute {
 	return superschema.SingleNestedAttribute{
 		Common: &schemaR.SingleNestedAttribute{
-			MarkdownDescription: "Default task parameters applied when this template or integration runs a task.",
+			MarkdownDescription: "Default task parameters applied when this template, integration or schedule runs a task.",
 		},
 		Resource: &schemaR.SingleNestedAttribute{
 			Optional: true,
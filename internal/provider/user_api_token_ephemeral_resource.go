@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/authentication"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+// userAPITokenPrivateKey is the private data key under which Open stores the
+// token for Close to expire it.
+const userAPITokenPrivateKey = "token"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &userAPITokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &userAPITokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &userAPITokenEphemeralResource{}
+)
+
+func NewUserAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &userAPITokenEphemeralResource{}
+}
+
+type userAPITokenEphemeralResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *userAPITokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *userAPITokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_token"
+}
+
+func (r *userAPITokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = UserAPITokenEphemeralSchema()
+}
+
+func (r *userAPITokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config UserAPITokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Authentication.PostUserTokens(&authentication.PostUserTokensParams{
+		Body: authentication.PostUserTokensBody{
+			Name: config.Name.ValueString(),
+		},
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI User API Token", "Could not create user API token", err)...)
+		return
+	}
+
+	token, err := json.Marshal(response.Payload.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating SemaphoreUI User API Token", "Could not store the user API token for expiry: "+err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, userAPITokenPrivateKey, token)...)
+
+	model := convertAPITokenResponseToUserAPITokenModel(response.Payload, config.Name)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// Close expires the token once Terraform no longer needs it. A token that was
+// already expired outside of Terraform is ignored.
+func (r *userAPITokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, userAPITokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	var token string
+	if err := json.Unmarshal(data, &token); err != nil {
+		resp.Diagnostics.AddError("Error Expiring SemaphoreUI User API Token", "Could not read the user API token to expire: "+err.Error())
+		return
+	}
+
+	_, err := r.client.Authentication.DeleteUserTokensAPITokenID(&authentication.DeleteUserTokensAPITokenIDParams{
+		APITokenID: token,
+	}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Expiring SemaphoreUI User API Token", "Could not expire user API token", err)...)
+	}
+}
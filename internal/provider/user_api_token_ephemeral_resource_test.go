@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func testAccUserAPITokenEphemeralConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+ephemeral "semaphoreui_user_api_token" "test" {
+  name = "Ephemeral-%[1]s"
+}
+
+provider "echo" {
+  data = ephemeral.semaphoreui_user_api_token.test
+}
+
+resource "echo" "test" {}
+`, nameSuffix)
+}
+
+func TestAcc_UserAPITokenEphemeralResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	var token string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// The token is only valid during the run: it is expired when
+			// Terraform closes the ephemeral resource.
+			{
+				Config: testAccUserAPITokenEphemeralConfig(nameSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.name", fmt.Sprintf("Ephemeral-%s", nameSuffix)),
+					resource.TestCheckResourceAttrSet("echo.test", "data.user_id"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.created"),
+					testAccCaptureAttr("echo.test", "data.token", &token),
+					resource.TestCheckResourceAttrWith("echo.test", "data.token", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("token is empty")
+						}
+						return nil
+					}),
+					testAccUserAPITokenExpired(&token),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/authentication"
+	"terraform-provider-semaphoreui/semaphoreui/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &userAPITokenResource{}
+	_ resource.ResourceWithConfigure = &userAPITokenResource{}
+)
+
+func NewUserAPITokenResource() resource.Resource {
+	return &userAPITokenResource{}
+}
+
+type userAPITokenResource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (r *userAPITokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	r.client = data.client
+}
+
+func (r *userAPITokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_token"
+}
+
+func (r *userAPITokenResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = UserAPITokenSchema().GetResource(ctx)
+}
+
+func convertAPITokenResponseToUserAPITokenModel(response *models.APIToken, name types.String) UserAPITokenModel {
+	return UserAPITokenModel{
+		// Older SemaphoreUI versions do not store a token name, so keep the
+		// configured value.
+		Name:    name,
+		Token:   types.StringValue(response.ID),
+		UserID:  types.Int64Value(response.UserID),
+		Created: types.StringValue(response.Created),
+	}
+}
+
+func (r *userAPITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserAPITokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Authentication.PostUserTokens(&authentication.PostUserTokensParams{
+		Body: authentication.PostUserTokensBody{
+			Name: plan.Name.ValueString(),
+		},
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI User API Token", "Could not create user API token", err)...)
+		return
+	}
+
+	model := convertAPITokenResponseToUserAPITokenModel(response.Payload, plan.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// findToken returns the token with the given ID from the tokens of the
+// authenticated user, or nil if there is none.
+func (r *userAPITokenResource) findToken(token string) (*models.APIToken, error) {
+	response, err := r.client.Authentication.GetUserTokens(&authentication.GetUserTokensParams{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range response.Payload {
+		if t.ID == token {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *userAPITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserAPITokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.findToken(state.Token.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI User API Token", "Could not read user API tokens", err)...)
+		return
+	}
+	if token == nil || token.Expired {
+		// Drift: token deleted or expired out-of-band. Remove from state so
+		// that a new token is created.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model := convertAPITokenResponseToUserAPITokenModel(token, state.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Update is never called as every configurable attribute requires a new token.
+func (r *userAPITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserAPITokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userAPITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserAPITokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Authentication.DeleteUserTokensAPITokenID(&authentication.DeleteUserTokensAPITokenIDParams{
+		APITokenID: state.Token.ValueString(),
+	}, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing SemaphoreUI User API Token", "Could not expire user API token", err)...)
+		return
+	}
+}
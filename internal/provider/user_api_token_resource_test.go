@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-semaphoreui/semaphoreui/client/authentication"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccUserAPITokenActive checks that the token in state is an unexpired
+// token of the authenticated user and stores it in token.
+func testAccUserAPITokenActive(resourceName string, token *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.Attributes["token"] == "" {
+			return fmt.Errorf("no token is set")
+		}
+
+		response, err := testClient().Authentication.GetUserTokens(&authentication.GetUserTokensParams{}, nil)
+		if err != nil {
+			return fmt.Errorf("could not list user API tokens: %s", err.Error())
+		}
+		for _, t := range response.Payload {
+			if t.ID != rs.Primary.Attributes["token"] {
+				continue
+			}
+			if t.Expired {
+				return fmt.Errorf("user API token is expired")
+			}
+			*token = t.ID
+			return nil
+		}
+		return fmt.Errorf("user API token not found")
+	}
+}
+
+// testAccUserAPITokenExpired checks that the token is no longer usable.
+func testAccUserAPITokenExpired(token *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		response, err := testClient().Authentication.GetUserTokens(&authentication.GetUserTokensParams{}, nil)
+		if err != nil {
+			return fmt.Errorf("could not list user API tokens: %s", err.Error())
+		}
+		for _, t := range response.Payload {
+			if t.ID == *token && !t.Expired {
+				return fmt.Errorf("user API token was not expired")
+			}
+		}
+		return nil
+	}
+}
+
+func testAccUserAPITokenConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_user_api_token" "test" {
+  name = "CI-%[1]s"
+}
+`, nameSuffix)
+}
+
+// testAccExpireUserAPITokenOutOfBand expires the token directly via the API
+// to simulate a token revoked outside Terraform (e.g. from the web UI).
+func testAccExpireUserAPITokenOutOfBand(token *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testClient().Authentication.DeleteUserTokensAPITokenID(&authentication.DeleteUserTokensAPITokenIDParams{
+			APITokenID: *token,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("error expiring user API token out-of-band: %s", err.Error())
+		}
+		return nil
+	}
+}
+
+func TestAcc_UserAPITokenResource_basic(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	var token, revoked string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccUserAPITokenExpired(&token),
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccUserAPITokenConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserAPITokenActive("semaphoreui_user_api_token.test", &token),
+					resource.TestCheckResourceAttr("semaphoreui_user_api_token.test", "name", fmt.Sprintf("CI-%s", nameSuffix)),
+					resource.TestCheckResourceAttrSet("semaphoreui_user_api_token.test", "user_id"),
+				),
+			},
+			// Revoke the token out of band, the plan creates a new one.
+			{
+				Config: testAccUserAPITokenConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserAPITokenActive("semaphoreui_user_api_token.test", &revoked),
+					testAccExpireUserAPITokenOutOfBand(&revoked),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Apply creates a new token.
+			{
+				Config: testAccUserAPITokenConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserAPITokenActive("semaphoreui_user_api_token.test", &token),
+					resource.TestCheckResourceAttrWith("semaphoreui_user_api_token.test", "token", func(value string) error {
+						if value == revoked {
+							return fmt.Errorf("user API token was not replaced")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	schemaE "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	superschema "github.com/orange-cloudavenue/terraform-plugin-framework-superschema"
+)
+
+type UserAPITokenModel struct {
+	Name    types.String `tfsdk:"name"`
+	Token   types.String `tfsdk:"token"`
+	UserID  types.Int64  `tfsdk:"user_id"`
+	Created types.String `tfsdk:"created"`
+}
+
+func UserAPITokenSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The user API token",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to create an API token for the user the provider is authenticated as. The token is expired when the resource is destroyed. A token that was expired outside of Terraform is created again on the next apply.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "A name describing what the token is used for (e.g. `CI`).",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				},
+			},
+			"token": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The API token. Use it as the `api_token` of a provider, or as a `Bearer` token for the SemaphoreUI API.",
+					Sensitive:           true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+			"user_id": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The ID of the user that owns the token.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				},
+			},
+			"created": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Creation date of the token.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+		},
+	}
+}
+
+// UserAPITokenEphemeralSchema is the schema of the ephemeral resource, which
+// superschema does not support.
+func UserAPITokenEphemeralSchema() schemaE.Schema {
+	return schemaE.Schema{
+		MarkdownDescription: "The user API token ephemeral resource creates an API token for the user the provider is authenticated as, for the duration of a Terraform run. " +
+			"The token is never stored in the plan or state, and is expired once Terraform no longer needs it. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schemaE.Attribute{
+			"name": schemaE.StringAttribute{
+				MarkdownDescription: "A name describing what the token is used for (e.g. `CI`).",
+				Optional:            true,
+			},
+			"token": schemaE.StringAttribute{
+				MarkdownDescription: "The API token. Use it as the `api_token` of a provider, or as a `Bearer` token for the SemaphoreUI API.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"user_id": schemaE.Int64Attribute{
+				MarkdownDescription: "The ID of the user that owns the token.",
+				Computed:            true,
+			},
+			"created": schemaE.StringAttribute{
+				MarkdownDescription: "Creation date of the token.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -28,15 +28,15 @@ func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -52,7 +52,7 @@ func (d *userDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest,
 func (d *userDataSource) GetUserModelByUsername(username types.String) (*UserModel, error) {
 	payload, err := d.client.User.GetUsers(&user.GetUsersParams{}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Users: %s", err.Error())
+		return nil, fmt.Errorf("could not read Users: %w", err)
 	}
 
 	for _, u := range payload.Payload {
@@ -67,7 +67,7 @@ func (d *userDataSource) GetUserModelByUsername(username types.String) (*UserMod
 func (d *userDataSource) GetUserModelByEmail(email types.String) (*UserModel, error) {
 	payload, err := d.client.User.GetUsers(&user.GetUsersParams{}, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Users: %s", err.Error())
+		return nil, fmt.Errorf("could not read Users: %w", err)
 	}
 
 	for _, u := range payload.Payload {
@@ -92,30 +92,21 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			UserID: config.ID.ValueInt64(),
 		}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Semaphore User",
-				fmt.Sprintf("Could not read user: %s", err.Error()),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore User", "Could not read user", err)...)
 			return
 		}
 		state = convertResponsePayloadToUserModel(response.Payload, UserModel{Password: types.StringValue("")})
 	} else if !config.Username.IsNull() && !config.Username.IsUnknown() {
 		u, err := d.GetUserModelByUsername(config.Username)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Semaphore User",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore User", "", err)...)
 			return
 		}
 		state = *u
 	} else if !config.Email.IsNull() && !config.Email.IsUnknown() {
 		u, err := d.GetUserModelByEmail(config.Email)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Semaphore User",
-				err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore User", "", err)...)
 			return
 		}
 		state = *u
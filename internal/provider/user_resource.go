@@ -2,8 +2,8 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,9 +14,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &userResource{}
-	_ resource.ResourceWithConfigure   = &userResource{}
-	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.Resource                     = &userResource{}
+	_ resource.ResourceWithConfigure        = &userResource{}
+	_ resource.ResourceWithImportState      = &userResource{}
+	_ resource.ResourceWithConfigValidators = &userResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -32,16 +33,16 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.SemaphoreUI)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Expected *client.SemaphoreUI, got %T. Please report this issue to the provider developers.",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
-	r.client = client
+	r.client = data.client
 }
 
 func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +53,25 @@ func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, res
 	resp.Schema = userSchema().GetResource(ctx)
 }
 
+func (r *userResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_wo"),
+		),
+	}
+}
+
+// resolvePassword returns the password bound for the API, from either
+// `password` or its write-only counterpart `password_wo`, which is only
+// available in the config.
+func resolvePassword(plan, config UserModel) string {
+	if !config.PasswordWO.IsNull() && !config.PasswordWO.IsUnknown() {
+		return config.PasswordWO.ValueString()
+	}
+	return plan.Password.ValueString()
+}
+
 func convertResponsePayloadToUserModel(user *models.User, prev UserModel) UserModel {
 	return UserModel{
 		ID:       types.Int64Value(user.ID),
@@ -63,16 +83,18 @@ func convertResponsePayloadToUserModel(user *models.User, prev UserModel) UserMo
 		External: types.BoolValue(user.External),
 		Alert:    types.BoolValue(user.Alert),
 		// Password is not returned by the API so we use previously set password
-		Password: prev.Password,
+		Password:          prev.Password,
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: prev.PasswordWOVersion,
 	}
 }
 
-func convertUserModelToUserRequest(user UserModel) *models.UserRequest {
+func convertUserModelToUserRequest(user UserModel, password string) *models.UserRequest {
 	return &models.UserRequest{
 		Username: user.Username.ValueString(),
 		Name:     user.Name.ValueString(),
 		Email:    user.Email.ValueString(),
-		Password: strfmt.Password(user.Password.ValueString()),
+		Password: strfmt.Password(password),
 		Admin:    user.Admin.ValueBool(),
 		Alert:    user.Alert.ValueBool(),
 		External: user.External.ValueBool(),
@@ -90,24 +112,23 @@ func convertUserModelToUserPutRequest(user UserModel) *models.UserPutRequest {
 }
 
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Retrieve values from plan
-	var plan UserModel
+	// Retrieve values from plan + config. WriteOnly attributes (password_wo)
+	// live in Config only.
+	var plan, config UserModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Generate API request body from plan
-	var payload = convertUserModelToUserRequest(plan)
+	var payload = convertUserModelToUserRequest(plan, resolvePassword(plan, config))
 
 	//Create new user
 	response, err := r.client.User.PostUsers(&user.PostUsersParams{User: payload}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating SemaphoreUI User",
-			"Could not create user, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating SemaphoreUI User", "Could not create user", err)...)
 		return
 	}
 
@@ -135,10 +156,12 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Get refreshed value from API
 	response, err := r.client.User.GetUsersUserID(&user.GetUsersUserIDParams{UserID: state.ID.ValueInt64()}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore User",
-			"Could not read user, unexpected error: "+err.Error(),
-		)
+		if isNotFound(err) {
+			// Drift: user deleted out-of-band. Remove from state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore User", "Could not read user", err)...)
 		return
 	}
 
@@ -155,10 +178,13 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan UserModel
+	// Retrieve values from plan, config, and state. WriteOnly values are in
+	// Config only.
+	var plan, config, state UserModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -169,33 +195,23 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update existing resource
 	_, err := r.client.User.PutUsersUserID(&user.PutUsersUserIDParams{UserID: plan.ID.ValueInt64(), User: payload}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Semaphore User",
-			"Could not update user, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating Semaphore User", "Could not update user", err)...)
 		return
 	}
 
-	// Update password if it's changed
-	var prevPassword types.String
-	req.State.GetAttribute(ctx, path.Root("password"), &prevPassword)
-	if plan.Password != prevPassword {
-		_, err := r.client.User.PostUsersUserIDPassword(&user.PostUsersUserIDPasswordParams{UserID: plan.ID.ValueInt64(), Password: user.PostUsersUserIDPasswordBody{Password: strfmt.Password(plan.Password.ValueString())}}, nil)
+	// Update password if it's changed. A write-only password is only sent
+	// when its version changes.
+	if !plan.Password.Equal(state.Password) || !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		_, err := r.client.User.PostUsersUserIDPassword(&user.PostUsersUserIDPasswordParams{UserID: plan.ID.ValueInt64(), Password: user.PostUsersUserIDPasswordBody{Password: strfmt.Password(resolvePassword(plan, config))}}, nil)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Updating Semaphore User Password",
-				"Could not update user password, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating Semaphore User Password", "Could not update user password", err)...)
 		}
 	}
 
 	// Fetch updated values as PutUsersUserIDParams does not return updated user
 	response, err := r.client.User.GetUsersUserID(&user.GetUsersUserIDParams{UserID: plan.ID.ValueInt64()}, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Semaphore User",
-			"Could not read user, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading Semaphore User", "Could not read user", err)...)
 		return
 	}
 
@@ -220,22 +236,16 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	// Delete existing resource
 	_, err := r.client.User.DeleteUsersUserID(&user.DeleteUsersUserIDParams{UserID: state.ID.ValueInt64()}, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Semaphore User",
-			fmt.Sprintf("Could not delete user, unexpected error: %s", err.Error()),
-		)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting Semaphore User", "Could not delete user", err)...)
 		return
 	}
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	fields, err := parseImportFields(req.ID, []string{"user"})
+	fields, err := parseImportFields(r.client, req.ID, []string{"user"})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid User Import ID",
-			"Could not parse import ID: "+err.Error(),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Invalid User Import ID", "Could not parse import ID", err)...)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fields["user"])...)
@@ -2,11 +2,14 @@ package provider
 
 import (
 	"fmt"
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"regexp"
 	"strconv"
+	"terraform-provider-semaphoreui/semaphoreui/client/authentication"
 	"terraform-provider-semaphoreui/semaphoreui/client/user"
+	"terraform-provider-semaphoreui/semaphoreui/models"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -33,6 +36,28 @@ func testAccUserExists(resourceName string) resource.TestCheckFunc {
 	}
 }
 
+// testAccUserCanLogIn checks that the user can log in with the password,
+// which is the only way to verify a password that is not kept in the state.
+func testAccUserCanLogIn(resourceName string, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		_, err := testClient().Authentication.PostAuthLogin(&authentication.PostAuthLoginParams{
+			LoginBody: &models.Login{
+				Auth:     rs.Primary.Attributes["username"],
+				Password: strfmt.Password(password),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("user %s could not log in: %s", rs.Primary.Attributes["username"], err.Error())
+		}
+		return nil
+	}
+}
+
 func testAccUserConfig(userNameSuffix string, userExtras string) string {
 	return fmt.Sprintf(`
 resource "semaphoreui_user" "test" {
@@ -123,6 +148,55 @@ password = "something"`),
 	})
 }
 
+// Write-only password flow: the password never appears in state, and a
+// password_wo_version bump sets the new password.
+func TestAcc_UserResource_writeOnlyPassword(t *testing.T) {
+	userNameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig(userNameSuffix, `password_wo = "password!"
+  password_wo_version = 1`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserExists("semaphoreui_user.test"),
+					testAccUserCanLogIn("semaphoreui_user.test", "password!"),
+					resource.TestCheckResourceAttr("semaphoreui_user.test", "password_wo_version", "1"),
+					// Write-only values never appear in state.
+					resource.TestCheckNoResourceAttr("semaphoreui_user.test", "password_wo"),
+					resource.TestCheckNoResourceAttr("semaphoreui_user.test", "password"),
+				),
+			},
+			{
+				Config: testAccUserConfig(userNameSuffix, `password_wo = "rotated!"
+  password_wo_version = 2`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserCanLogIn("semaphoreui_user.test", "rotated!"),
+					resource.TestCheckResourceAttr("semaphoreui_user.test", "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+// Negative test: setting both password and password_wo at the same time
+// must fail the Conflicting validator at plan time.
+func TestAcc_UserResource_writeOnlyPasswordMutexRejected(t *testing.T) {
+	userNameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig(userNameSuffix, `password = "persisted"
+  password_wo = "ephemeral"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 func TestAcc_UserResource_errorOnExists(t *testing.T) {
 	userNameSuffix := acctest.RandString(8)
 	resource.Test(t, resource.TestCase{
@@ -133,7 +207,32 @@ func TestAcc_UserResource_errorOnExists(t *testing.T) {
 			// Create and Read testing
 			{
 				Config:      testAccUserConfig_Exists(userNameSuffix),
-				ExpectError: regexp.MustCompile("Could not create user, unexpected error"),
+				ExpectError: regexp.MustCompile("Could not create user: POST /users returned"),
+			},
+		},
+	})
+}
+
+// TestAcc_UserResource_disappears verifies that a user deleted out-of-band
+// is removed from the state and planned for recreation.
+func TestAcc_UserResource_disappears(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig(nameSuffix, `password = "password!"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserExists("semaphoreui_user.test"),
+					testAccDeleteOutOfBand("semaphoreui_user.test", func(attributes map[string]string) error {
+						_, err := testClient().User.DeleteUsersUserID(&user.DeleteUsersUserIDParams{
+							UserID: testAccInt64Attribute(attributes, "id"),
+						}, nil)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
@@ -26,6 +26,9 @@ type UserModel struct {
 	External types.Bool   `tfsdk:"external"`
 	Alert    types.Bool   `tfsdk:"alert"`
 	Password types.String `tfsdk:"password"`
+
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 func userSchema() superschema.Schema {
@@ -128,7 +131,7 @@ func userSchema() superschema.Schema {
 					Sensitive: true,
 				},
 				Resource: &schemaR.StringAttribute{
-					MarkdownDescription: "Login Password. This value is never returned by the API and will be an empty string after import.",
+					MarkdownDescription: "Login Password. Persisted to Terraform state. Set at most one of `password` or `password_wo`. This value is never returned by the API and will be an empty string after import.",
 					Optional:            true,
 					PlanModifiers: []planmodifier.String{
 						stringplanmodifier.UseStateForUnknown(),
@@ -139,6 +142,27 @@ func userSchema() superschema.Schema {
 					Computed:            true,
 				},
 			},
+			"password_wo": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "Write-only variant of `password` — accepts ephemeral values (e.g. from `vault_kv_secret_v2`) and is never persisted to Terraform state. Mutually exclusive with `password`. Bump `password_wo_version` to push a new value to SemaphoreUI.",
+					Optional:            true,
+					Sensitive:           true,
+					WriteOnly:           true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed:  true,
+					Sensitive: true,
+				},
+			},
+			"password_wo_version": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "Version trigger for `password_wo`. Increment to instruct the provider to re-read the write-only value and push it to SemaphoreUI. Only meaningful when `password_wo` is set.",
+					Optional:            true,
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Computed: true,
+				},
+			},
 			"admin": superschema.BoolAttribute{
 				Common: &schemaR.BoolAttribute{
 					MarkdownDescription: "Indicates if the user is an admin.",
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	apiclient "terraform-provider-semaphoreui/semaphoreui/client"
+	"terraform-provider-semaphoreui/semaphoreui/client/user"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &usersDataSource{}
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *apiclient.SemaphoreUI
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.providerData, got %T. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	d.client = data.client
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+type usersDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Users     []UserModel  `tfsdk:"users"`
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	userAttributes := readOnlyAttributes(userSchema().GetDataSource(ctx).Attributes)
+	userAttributes["email"] = schema.StringAttribute{
+		MarkdownDescription: "Email address.",
+		Computed:            true,
+	}
+	userAttributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The ID of the user.",
+		Computed:            true,
+	}
+	userAttributes["username"] = schema.StringAttribute{
+		MarkdownDescription: "Username.",
+		Computed:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a List of SemaphoreUI Users.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": nameRegexAttribute("users", "username"),
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the users that match the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config usersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	matchesName, diags := nameMatcher(config.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.User.GetUsers(&user.GetUsersParams{}, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Reading SemaphoreUI Users", "Could not read users", err)...)
+		return
+	}
+
+	config.Users = []UserModel{}
+	for _, u := range response.Payload {
+		if !matchesName(u.Username) {
+			continue
+		}
+		config.Users = append(config.Users, convertResponsePayloadToUserModel(u, UserModel{Password: types.StringValue("")}))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
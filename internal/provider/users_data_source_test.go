@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccUsersDataSourceConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "semaphoreui_user" "alice" {
+  username = "alice-%[1]s"
+  name     = "Alice"
+  email    = "alice-%[1]s@example.com"
+}
+
+resource "semaphoreui_user" "bob" {
+  username = "bob-%[1]s"
+  name     = "Bob"
+  email    = "bob-%[1]s@example.com"
+}
+
+data "semaphoreui_users" "test" {
+  name_regex = "^alice-%[1]s$"
+  depends_on = [semaphoreui_user.alice, semaphoreui_user.bob]
+}`, nameSuffix)
+}
+
+func TestAcc_UsersDataSource_filter(t *testing.T) {
+	nameSuffix := acctest.RandString(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig(nameSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.semaphoreui_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.semaphoreui_users.test", "users.0.username", fmt.Sprintf("alice-%s", nameSuffix)),
+					resource.TestCheckResourceAttr("data.semaphoreui_users.test", "users.0.name", "Alice"),
+					resource.TestCheckResourceAttrPair("data.semaphoreui_users.test", "users.0.id", "semaphoreui_user.alice", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"time"
+)
+
+var _ validator.String = DurationValidator{}
+
+type DurationValidator struct{}
+
+func (v DurationValidator) Description(ctx context.Context) string {
+	return "Must be a positive Go duration, e.g. 30m or 1h30m."
+}
+
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "Must be a positive [Go duration](https://pkg.go.dev/time#ParseDuration), e.g. `30m` or `1h30m`."
+}
+
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%s must be a positive Go duration such as 30m or 1h30m, got %s", req.Path, req.ConfigValue.ValueString()),
+		)
+		return
+	}
+}
+
+func Duration() DurationValidator {
+	return DurationValidator{}
+}
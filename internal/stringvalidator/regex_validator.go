@@ -0,0 +1,40 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"regexp"
+)
+
+var _ validator.String = RegexValidator{}
+
+type RegexValidator struct{}
+
+func (v RegexValidator) Description(ctx context.Context) string {
+	return "Must be a valid regular expression."
+}
+
+func (v RegexValidator) MarkdownDescription(ctx context.Context) string {
+	return "Must be a valid [RE2](https://github.com/google/re2/wiki/Syntax) regular expression."
+}
+
+func (v RegexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regular expression",
+			fmt.Sprintf("%s must be a valid regular expression, got %s: %s", req.Path, req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+}
+
+func Regex() RegexValidator {
+	return RegexValidator{}
+}
@@ -0,0 +1,40 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"time"
+)
+
+var _ validator.String = RFC3339Validator{}
+
+type RFC3339Validator struct{}
+
+func (v RFC3339Validator) Description(ctx context.Context) string {
+	return "Must be a valid RFC 3339 timestamp, e.g. 2025-01-31T22:00:00Z."
+}
+
+func (v RFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "Must be a valid [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339) timestamp, e.g. `2025-01-31T22:00:00Z`."
+}
+
+func (v RFC3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid timestamp",
+			fmt.Sprintf("%s must be a valid RFC 3339 timestamp, got %s", req.Path, req.ConfigValue.ValueString()),
+		)
+		return
+	}
+}
+
+func RFC3339() RFC3339Validator {
+	return RFC3339Validator{}
+}
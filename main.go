@@ -3,13 +3,21 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"terraform-provider-semaphoreui/internal/provider"
 )
 
+// closeTimeout bounds the time spent ending sessions when the provider exits,
+// as Terraform kills the provider after 2 seconds.
+const closeTimeout = 1500 * time.Millisecond
+
 var (
 	// these will be set by the goreleaser configuration
 	// to appropriate values for the compiled binary.
@@ -25,6 +33,11 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	if flag.Arg(0) == "generate-imports" {
+		generateImports(flag.Args()[1:])
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/semaphoreui/semaphore",
 		Debug:   debug,
@@ -32,7 +45,53 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Terraform gives the provider a moment to exit once it is no longer
+	// needed, in which the sessions of username and password logins end.
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	if closeErr := provider.Close(ctx); closeErr != nil {
+		log.Printf("[WARN] could not end the SemaphoreUI sessions: %s", closeErr)
+	}
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// generateImports writes the configuration and import blocks of the objects
+// of an existing SemaphoreUI instance to adopt it with Terraform. The
+// instance is configured with the same environment variables as the provider.
+func generateImports(args []string) {
+	flags := flag.NewFlagSet("generate-imports", flag.ExitOnError)
+	projects := flags.String("projects", "", "comma-separated IDs or names of the projects to generate, all projects by default")
+	output := flags.String("output", "", "file to write the configuration to, standard output by default")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate-imports [flags]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Generates semaphoreui_* resources and import blocks for the objects of a SemaphoreUI instance.")
+		fmt.Fprintln(flags.Output(), "The instance is configured with the SEMAPHOREUI_* environment variables of the provider.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	var selection []string
+	for _, project := range strings.Split(*projects, ",") {
+		if project = strings.TrimSpace(project); project != "" {
+			selection = append(selection, project)
+		}
+	}
+
+	w := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := provider.GenerateImports(context.Background(), version, selection, w); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -88,6 +88,12 @@ type ClientService interface {
 	// DeleteProjectProjectIDContext delete project.
 	DeleteProjectProjectIDContext(ctx context.Context, params *DeleteProjectProjectIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDNoContent, error)
 
+	// DeleteProjectProjectIDInvitesInviteID delete project invitation.
+	DeleteProjectProjectIDInvitesInviteID(params *DeleteProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDInvitesInviteIDNoContent, error)
+
+	// DeleteProjectProjectIDInvitesInviteIDContext delete project invitation.
+	DeleteProjectProjectIDInvitesInviteIDContext(ctx context.Context, params *DeleteProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDInvitesInviteIDNoContent, error)
+
 	// DeleteProjectProjectIDUsersUserID removes user from project.
 	DeleteProjectProjectIDUsersUserID(params *DeleteProjectProjectIDUsersUserIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDUsersUserIDNoContent, error)
 
@@ -118,6 +124,18 @@ type ClientService interface {
 	// GetProjectProjectIDEventsContext get events related to this project.
 	GetProjectProjectIDEventsContext(ctx context.Context, params *GetProjectProjectIDEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDEventsOK, error)
 
+	// GetProjectProjectIDInvites get invitations for project.
+	GetProjectProjectIDInvites(params *GetProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesOK, error)
+
+	// GetProjectProjectIDInvitesContext get invitations for project.
+	GetProjectProjectIDInvitesContext(ctx context.Context, params *GetProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesOK, error)
+
+	// GetProjectProjectIDInvitesInviteID get specific project invitation.
+	GetProjectProjectIDInvitesInviteID(params *GetProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesInviteIDOK, error)
+
+	// GetProjectProjectIDInvitesInviteIDContext get specific project invitation.
+	GetProjectProjectIDInvitesInviteIDContext(ctx context.Context, params *GetProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesInviteIDOK, error)
+
 	// GetProjectProjectIDRole fetch permissions of the current user for project.
 	GetProjectProjectIDRole(params *GetProjectProjectIDRoleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDRoleOK, error)
 
@@ -148,6 +166,12 @@ type ClientService interface {
 	// GetProjectsContext get projects.
 	GetProjectsContext(ctx context.Context, params *GetProjectsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectsOK, error)
 
+	// PostProjectProjectIDInvites create project invitation.
+	PostProjectProjectIDInvites(params *PostProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDInvitesCreated, error)
+
+	// PostProjectProjectIDInvitesContext create project invitation.
+	PostProjectProjectIDInvitesContext(ctx context.Context, params *PostProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDInvitesCreated, error)
+
 	// PostProjectProjectIDNotificationsTest send test notification.
 	PostProjectProjectIDNotificationsTest(params *PostProjectProjectIDNotificationsTestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) error
 
@@ -184,6 +208,12 @@ type ClientService interface {
 	// PutProjectProjectIDContext update project.
 	PutProjectProjectIDContext(ctx context.Context, params *PutProjectProjectIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDNoContent, error)
 
+	// PutProjectProjectIDInvitesInviteID update project invitation status.
+	PutProjectProjectIDInvitesInviteID(params *PutProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDInvitesInviteIDNoContent, error)
+
+	// PutProjectProjectIDInvitesInviteIDContext update project invitation status.
+	PutProjectProjectIDInvitesInviteIDContext(ctx context.Context, params *PutProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDInvitesInviteIDNoContent, error)
+
 	// PutProjectProjectIDUsersUserID update user role.
 	PutProjectProjectIDUsersUserID(params *PutProjectProjectIDUsersUserIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDUsersUserIDNoContent, error)
 
@@ -266,6 +296,73 @@ func (a *Client) DeleteProjectProjectIDContext(ctx context.Context, params *Dele
 	panic(msg)
 }
 
+/*
+DeleteProjectProjectIDInvitesInviteIDdeletes project invitation.
+
+This method does not support injected context.
+However, timeout and opentracing contexts are honored whenever enabled.
+
+If you need to pass a specific context, use [Client.DeleteProjectProjectIDInvitesInviteIDContext] instead.
+*/
+func (a *Client) DeleteProjectProjectIDInvitesInviteID(params *DeleteProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDInvitesInviteIDNoContent, error) {
+	var ctx context.Context
+	if params.inner.ctx != nil {
+		ctx = params.inner.ctx
+	} else {
+		ctx = context.Background()
+	}
+
+	return a.DeleteProjectProjectIDInvitesInviteIDContext(ctx, params, authInfo, opts...)
+}
+
+/*
+DeleteProjectProjectIDInvitesInviteIDContextdeletes project invitation.
+
+Do not use the deprecated [DeleteProjectProjectIDInvitesInviteIDParams.Context] with this method: it would be ignored.
+*/
+func (a *Client) DeleteProjectProjectIDInvitesInviteIDContext(ctx context.Context, params *DeleteProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectProjectIDInvitesInviteIDNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewDeleteProjectProjectIDInvitesInviteIDParams()
+	}
+
+	op := &runtime.ClientOperation{
+		ID:                 "DeleteProjectProjectIDInvitesInviteID",
+		Method:             "DELETE",
+		PathPattern:        "/project/{project_id}/invites/{invite_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteProjectProjectIDInvitesInviteIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Client:             params.HTTPClient,
+	}
+
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.SubmitContext(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*DeleteProjectProjectIDInvitesInviteIDNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteProjectProjectIDInvitesInviteID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 DeleteProjectProjectIDUsersUserIDremoves user from project.
 
@@ -601,6 +698,140 @@ func (a *Client) GetProjectProjectIDEventsContext(ctx context.Context, params *G
 	panic(msg)
 }
 
+/*
+GetProjectProjectIDInvitesgets invitations for project.
+
+This method does not support injected context.
+However, timeout and opentracing contexts are honored whenever enabled.
+
+If you need to pass a specific context, use [Client.GetProjectProjectIDInvitesContext] instead.
+*/
+func (a *Client) GetProjectProjectIDInvites(params *GetProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesOK, error) {
+	var ctx context.Context
+	if params.inner.ctx != nil {
+		ctx = params.inner.ctx
+	} else {
+		ctx = context.Background()
+	}
+
+	return a.GetProjectProjectIDInvitesContext(ctx, params, authInfo, opts...)
+}
+
+/*
+GetProjectProjectIDInvitesContextgets invitations for project.
+
+Do not use the deprecated [GetProjectProjectIDInvitesParams.Context] with this method: it would be ignored.
+*/
+func (a *Client) GetProjectProjectIDInvitesContext(ctx context.Context, params *GetProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewGetProjectProjectIDInvitesParams()
+	}
+
+	op := &runtime.ClientOperation{
+		ID:                 "GetProjectProjectIDInvites",
+		Method:             "GET",
+		PathPattern:        "/project/{project_id}/invites",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetProjectProjectIDInvitesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Client:             params.HTTPClient,
+	}
+
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.SubmitContext(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*GetProjectProjectIDInvitesOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetProjectProjectIDInvites: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetProjectProjectIDInvitesInviteIDgets specific project invitation.
+
+This method does not support injected context.
+However, timeout and opentracing contexts are honored whenever enabled.
+
+If you need to pass a specific context, use [Client.GetProjectProjectIDInvitesInviteIDContext] instead.
+*/
+func (a *Client) GetProjectProjectIDInvitesInviteID(params *GetProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesInviteIDOK, error) {
+	var ctx context.Context
+	if params.inner.ctx != nil {
+		ctx = params.inner.ctx
+	} else {
+		ctx = context.Background()
+	}
+
+	return a.GetProjectProjectIDInvitesInviteIDContext(ctx, params, authInfo, opts...)
+}
+
+/*
+GetProjectProjectIDInvitesInviteIDContextgets specific project invitation.
+
+Do not use the deprecated [GetProjectProjectIDInvitesInviteIDParams.Context] with this method: it would be ignored.
+*/
+func (a *Client) GetProjectProjectIDInvitesInviteIDContext(ctx context.Context, params *GetProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDInvitesInviteIDOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewGetProjectProjectIDInvitesInviteIDParams()
+	}
+
+	op := &runtime.ClientOperation{
+		ID:                 "GetProjectProjectIDInvitesInviteID",
+		Method:             "GET",
+		PathPattern:        "/project/{project_id}/invites/{invite_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetProjectProjectIDInvitesInviteIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Client:             params.HTTPClient,
+	}
+
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.SubmitContext(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*GetProjectProjectIDInvitesInviteIDOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetProjectProjectIDInvitesInviteID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetProjectProjectIDRolefetches permissions of the current user for project.
 
@@ -936,6 +1167,73 @@ func (a *Client) GetProjectsContext(ctx context.Context, params *GetProjectsPara
 	panic(msg)
 }
 
+/*
+PostProjectProjectIDInvitescreates project invitation.
+
+This method does not support injected context.
+However, timeout and opentracing contexts are honored whenever enabled.
+
+If you need to pass a specific context, use [Client.PostProjectProjectIDInvitesContext] instead.
+*/
+func (a *Client) PostProjectProjectIDInvites(params *PostProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDInvitesCreated, error) {
+	var ctx context.Context
+	if params.inner.ctx != nil {
+		ctx = params.inner.ctx
+	} else {
+		ctx = context.Background()
+	}
+
+	return a.PostProjectProjectIDInvitesContext(ctx, params, authInfo, opts...)
+}
+
+/*
+PostProjectProjectIDInvitesContextcreates project invitation.
+
+Do not use the deprecated [PostProjectProjectIDInvitesParams.Context] with this method: it would be ignored.
+*/
+func (a *Client) PostProjectProjectIDInvitesContext(ctx context.Context, params *PostProjectProjectIDInvitesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDInvitesCreated, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPostProjectProjectIDInvitesParams()
+	}
+
+	op := &runtime.ClientOperation{
+		ID:                 "PostProjectProjectIDInvites",
+		Method:             "POST",
+		PathPattern:        "/project/{project_id}/invites",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PostProjectProjectIDInvitesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Client:             params.HTTPClient,
+	}
+
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.SubmitContext(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PostProjectProjectIDInvitesCreated)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostProjectProjectIDInvites: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PostProjectProjectIDNotificationsTestsends test notification.
 
@@ -1331,6 +1629,73 @@ func (a *Client) PutProjectProjectIDContext(ctx context.Context, params *PutProj
 	panic(msg)
 }
 
+/*
+PutProjectProjectIDInvitesInviteIDupdates project invitation status.
+
+This method does not support injected context.
+However, timeout and opentracing contexts are honored whenever enabled.
+
+If you need to pass a specific context, use [Client.PutProjectProjectIDInvitesInviteIDContext] instead.
+*/
+func (a *Client) PutProjectProjectIDInvitesInviteID(params *PutProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDInvitesInviteIDNoContent, error) {
+	var ctx context.Context
+	if params.inner.ctx != nil {
+		ctx = params.inner.ctx
+	} else {
+		ctx = context.Background()
+	}
+
+	return a.PutProjectProjectIDInvitesInviteIDContext(ctx, params, authInfo, opts...)
+}
+
+/*
+PutProjectProjectIDInvitesInviteIDContextupdates project invitation status.
+
+Do not use the deprecated [PutProjectProjectIDInvitesInviteIDParams.Context] with this method: it would be ignored.
+*/
+func (a *Client) PutProjectProjectIDInvitesInviteIDContext(ctx context.Context, params *PutProjectProjectIDInvitesInviteIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PutProjectProjectIDInvitesInviteIDNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPutProjectProjectIDInvitesInviteIDParams()
+	}
+
+	op := &runtime.ClientOperation{
+		ID:                 "PutProjectProjectIDInvitesInviteID",
+		Method:             "PUT",
+		PathPattern:        "/project/{project_id}/invites/{invite_id}",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PutProjectProjectIDInvitesInviteIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Client:             params.HTTPClient,
+	}
+
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.SubmitContext(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PutProjectProjectIDInvitesInviteIDNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PutProjectProjectIDInvitesInviteID: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PutProjectProjectIDUsersUserIDupdates user role.
 
@@ -94,6 +94,12 @@ type ClientService interface {
 	// GetProjectProjectIDSchedulesScheduleIDContext get schedule.
 	GetProjectProjectIDSchedulesScheduleIDContext(ctx context.Context, params *GetProjectProjectIDSchedulesScheduleIDParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDSchedulesScheduleIDOK, error)
 
+	// GetProjectProjectIDTemplatesTemplateIDSchedules get template schedules.
+	GetProjectProjectIDTemplatesTemplateIDSchedules(params *GetProjectProjectIDTemplatesTemplateIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDTemplatesTemplateIDSchedulesOK, error)
+
+	// GetProjectProjectIDTemplatesTemplateIDSchedulesContext get template schedules.
+	GetProjectProjectIDTemplatesTemplateIDSchedulesContext(ctx context.Context, params *GetProjectProjectIDTemplatesTemplateIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDTemplatesTemplateIDSchedulesOK, error)
+
 	// PostProjectProjectIDSchedules create schedule.
 	PostProjectProjectIDSchedules(params *PostProjectProjectIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PostProjectProjectIDSchedulesCreated, error)
 
@@ -243,6 +249,73 @@ func (a *Client) GetProjectProjectIDSchedulesScheduleIDContext(ctx context.Conte
 	panic(msg)
 }
 
+/*
+GetProjectProjectIDTemplatesTemplateIDSchedulesgets template schedules.
+
+This method does not support injected context.
+However, timeout and opentracing contexts are honored whenever enabled.
+
+If you need to pass a specific context, use [Client.GetProjectProjectIDTemplatesTemplateIDSchedulesContext] instead.
+*/
+func (a *Client) GetProjectProjectIDTemplatesTemplateIDSchedules(params *GetProjectProjectIDTemplatesTemplateIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDTemplatesTemplateIDSchedulesOK, error) {
+	var ctx context.Context
+	if params.inner.ctx != nil {
+		ctx = params.inner.ctx
+	} else {
+		ctx = context.Background()
+	}
+
+	return a.GetProjectProjectIDTemplatesTemplateIDSchedulesContext(ctx, params, authInfo, opts...)
+}
+
+/*
+GetProjectProjectIDTemplatesTemplateIDSchedulesContextgets template schedules.
+
+Do not use the deprecated [GetProjectProjectIDTemplatesTemplateIDSchedulesParams.Context] with this method: it would be ignored.
+*/
+func (a *Client) GetProjectProjectIDTemplatesTemplateIDSchedulesContext(ctx context.Context, params *GetProjectProjectIDTemplatesTemplateIDSchedulesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectProjectIDTemplatesTemplateIDSchedulesOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewGetProjectProjectIDTemplatesTemplateIDSchedulesParams()
+	}
+
+	op := &runtime.ClientOperation{
+		ID:                 "GetProjectProjectIDTemplatesTemplateIDSchedules",
+		Method:             "GET",
+		PathPattern:        "/project/{project_id}/templates/{template_id}/schedules",
+		ProducesMediaTypes: []string{"application/json", "text/plain; charset=utf-8"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetProjectProjectIDTemplatesTemplateIDSchedulesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Client:             params.HTTPClient,
+	}
+
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.SubmitContext(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*GetProjectProjectIDTemplatesTemplateIDSchedulesOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetProjectProjectIDTemplatesTemplateIDSchedules: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PostProjectProjectIDSchedulescreates schedule.
 
@@ -3,7 +3,10 @@
 package task
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -40,6 +43,8 @@ output
 */
 type GetProjectProjectIDTasksTaskIDRawOutputOK struct {
 	ContentType string
+
+	Payload string
 }
 
 // IsSuccess returns true when this get project project Id tasks task Id raw output o k response has a 2xx status code
@@ -73,11 +78,17 @@ func (o *GetProjectProjectIDTasksTaskIDRawOutputOK) Code() int {
 }
 
 func (o *GetProjectProjectIDTasksTaskIDRawOutputOK) Error() string {
-	return fmt.Sprintf("[GET /project/{project_id}/tasks/{task_id}/raw_output][%d] getProjectProjectIdTasksTaskIdRawOutputOK", 200)
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /project/{project_id}/tasks/{task_id}/raw_output][%d] getProjectProjectIdTasksTaskIdRawOutputOK %s", 200, payload)
 }
 
 func (o *GetProjectProjectIDTasksTaskIDRawOutputOK) String() string {
-	return fmt.Sprintf("[GET /project/{project_id}/tasks/{task_id}/raw_output][%d] getProjectProjectIdTasksTaskIdRawOutputOK", 200)
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /project/{project_id}/tasks/{task_id}/raw_output][%d] getProjectProjectIdTasksTaskIdRawOutputOK %s", 200, payload)
+}
+
+func (o *GetProjectProjectIDTasksTaskIDRawOutputOK) GetPayload() string {
+	return o.Payload
 }
 
 func (o *GetProjectProjectIDTasksTaskIDRawOutputOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
@@ -89,5 +100,10 @@ func (o *GetProjectProjectIDTasksTaskIDRawOutputOK) readResponse(response runtim
 		o.ContentType = hdrContentType
 	}
 
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
 	return nil
 }
@@ -9,9 +9,11 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag/jsonutils"
+	"github.com/go-openapi/swag/typeutils"
 	"terraform-provider-semaphoreui/semaphoreui/models"
 )
 
@@ -137,6 +139,13 @@ type PostProjectProjectIDTasksBody struct {
 	// message
 	Message string `json:"message,omitempty"`
 
+	// params
+	Params struct {
+		models.AnsibleTaskParams
+
+		models.TerraformTaskParams
+	} `json:"params,omitempty"`
+
 	// playbook
 	Playbook string `json:"playbook,omitempty"`
 
@@ -146,11 +155,42 @@ type PostProjectProjectIDTasksBody struct {
 
 // Validate validates this post project project ID tasks body
 func (o *PostProjectProjectIDTasksBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostProjectProjectIDTasksBody) validateParams(formats strfmt.Registry) error {
+	if typeutils.IsZero(o.Params) { // not required
+		return nil
+	}
+
 	return nil
 }
 
 // ContextValidate validates this post project project ID tasks body based on context it is used
 func (o *PostProjectProjectIDTasksBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostProjectProjectIDTasksBody) contextValidateParams(ctx context.Context, formats strfmt.Registry) error {
+
 	return nil
 }
 
@@ -14,6 +14,9 @@ import (
 // swagger:model Event
 type Event struct {
 
+	// created
+	Created string `json:"created,omitempty"`
+
 	// description
 	Description string `json:"description,omitempty"`
 
@@ -28,6 +31,9 @@ type Event struct {
 
 	// user id
 	UserID int64 `json:"user_id,omitempty"`
+
+	// username
+	Username string `json:"username,omitempty"`
 }
 
 // Validate validates this event
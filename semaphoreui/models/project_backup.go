@@ -949,7 +949,7 @@ type ProjectBackupTemplatesItems0 struct {
 	SuppressSuccessAlerts bool `json:"suppress_success_alerts,omitempty"`
 
 	// survey vars
-	SurveyVars string `json:"survey_vars,omitempty"`
+	SurveyVars []*TemplateSurveyVar `json:"survey_vars"`
 
 	// type
 	Type string `json:"type,omitempty"`
@@ -963,11 +963,88 @@ type ProjectBackupTemplatesItems0 struct {
 
 // Validate validates this project backup templates items0
 func (m *ProjectBackupTemplatesItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSurveyVars(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this project backup templates items0 based on context it is used
+func (m *ProjectBackupTemplatesItems0) validateSurveyVars(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.SurveyVars) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SurveyVars); i++ {
+		if typeutils.IsZero(m.SurveyVars[i]) { // not required
+			continue
+		}
+
+		if m.SurveyVars[i] != nil {
+			if err := m.SurveyVars[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("survey_vars" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("survey_vars" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this project backup templates items0 based on the context it is used
 func (m *ProjectBackupTemplatesItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSurveyVars(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProjectBackupTemplatesItems0) contextValidateSurveyVars(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.SurveyVars); i++ {
+
+		if m.SurveyVars[i] != nil {
+
+			if typeutils.IsZero(m.SurveyVars[i]) { // not required
+				return nil
+			}
+
+			if err := m.SurveyVars[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("survey_vars" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("survey_vars" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -1000,6 +1077,9 @@ type ProjectBackupViewsItems0 struct {
 	// position
 	// Minimum: 0
 	Position *int64 `json:"position,omitempty"`
+
+	// title
+	Title string `json:"title,omitempty"`
 }
 
 // Validate validates this project backup views items0
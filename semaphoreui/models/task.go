@@ -19,6 +19,12 @@ type Task struct {
 	// arguments
 	Arguments string `json:"arguments,omitempty"`
 
+	// commit hash
+	CommitHash string `json:"commit_hash,omitempty"`
+
+	// commit message
+	CommitMessage string `json:"commit_message,omitempty"`
+
 	// environment
 	Environment string `json:"environment,omitempty"`
 
@@ -56,6 +62,9 @@ type Task struct {
 
 	// template id
 	TemplateID int64 `json:"template_id,omitempty"`
+
+	// version
+	Version string `json:"version,omitempty"`
 }
 
 // Validate validates this task